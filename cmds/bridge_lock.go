@@ -0,0 +1,88 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type BridgeLockCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender    currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address; nft owner" required:"true"`
+	Contract  currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	NFT       uint64                      `arg:"" name:"nft" help:"target nft"`
+	Chain     string                      `arg:"" name:"chain" help:"destination chain, eg. eip155:1" required:"true"`
+	Recipient string                      `arg:"" name:"recipient" help:"recipient address in destination chain" required:"true"`
+	Currency  currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender    base.Address
+	contract  base.Address
+}
+
+func (cmd *BridgeLockCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *BridgeLockCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	return nil
+}
+
+func (cmd *BridgeLockCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create bridge-lock operation")
+
+	fact := nft.NewBridgeLockFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.NFT,
+		cmd.Chain,
+		cmd.Recipient,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewBridgeLock(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
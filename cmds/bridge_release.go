@@ -0,0 +1,131 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum-nft/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type BridgeReleaseCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	NFT         uint64                      `arg:"" name:"nft" help:"bridged nft"`
+	Receiver    currencycmds.AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	RelayerSign []RelayerSignFlag           `name:"relayer-sign" help:"relayer sign over release message; \"<publickey>,<signature>\"" optional:""`
+	RelayerKey  []string                    `name:"relayer-key" help:"relayer privatekey to sign release message locally" optional:""`
+	Nonce       uint64                      `name:"nonce" help:"nonce of bridge lock, used with --relayer-key" optional:""`
+	sender      base.Address
+	contract    base.Address
+	receiver    base.Address
+	signs       []types.RelayerSign
+}
+
+func (cmd *BridgeReleaseCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *BridgeReleaseCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	if a, err := cmd.Receiver.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid receiver address format, %v", cmd.Receiver)
+	} else {
+		cmd.receiver = a
+	}
+
+	signs := make([]types.RelayerSign, 0, len(cmd.RelayerSign)+len(cmd.RelayerKey))
+	for i := range cmd.RelayerSign {
+		s, err := cmd.RelayerSign[i].Encode(cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid relayer sign format, %v", cmd.RelayerSign[i].String())
+		}
+		signs = append(signs, s)
+	}
+
+	// NOTE the release message is signed locally with the relayer keys, which
+	// stand in for the relayers of the remote chain.
+	if len(cmd.RelayerKey) > 0 {
+		msg := types.BridgeReleaseMessage(cmd.NetworkID.NetworkID(), cmd.contract, cmd.NFT, cmd.Nonce, cmd.receiver)
+		for i := range cmd.RelayerKey {
+			priv, err := base.DecodePrivatekeyFromString(cmd.RelayerKey[i], cmd.Encoders.JSON())
+			if err != nil {
+				return errors.Wrap(err, "invalid relayer privatekey format")
+			}
+
+			s, err := types.NewRelayerSignFromBytes(priv, msg)
+			if err != nil {
+				return err
+			}
+			signs = append(signs, s)
+		}
+	}
+
+	if len(signs) < 1 {
+		return errors.Errorf("empty relayer signs; --relayer-sign or --relayer-key is required")
+	}
+	cmd.signs = signs
+
+	return nil
+}
+
+func (cmd *BridgeReleaseCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create bridge-release operation")
+
+	fact := nft.NewBridgeReleaseFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.NFT,
+		cmd.receiver,
+		cmd.signs,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewBridgeRelease(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -14,19 +14,25 @@ import (
 type CreateCollectionCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender    currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract  currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract account to register policy" required:"true"`
-	Name      string                      `arg:"" name:"name" help:"collection name" required:"true"`
-	Royalty   uint                        `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
-	Currency  currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	URI       string                      `name:"uri" help:"collection uri" optional:""`
-	White     currencycmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
-	sender    mitumbase.Address
-	contract  mitumbase.Address
-	name      types.CollectionName
-	royalty   types.PaymentParameter
-	uri       types.URI
-	whitelist []mitumbase.Address
+	CollectionMetadataFlags
+	URIPolicyFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract account to register policy" required:"true"`
+	Name       string                      `arg:"" name:"name" help:"collection name" required:"true"`
+	Royalty    uint                        `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	URI        string                      `name:"uri" help:"collection uri" optional:""`
+	White      currencycmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
+	Clawback   bool                        `name:"clawback" help:"allow creator to force transfer nfts" optional:""`
+	UniqueHash bool                        `name:"unique-hash" help:"reject minting nfts with an nft hash already used in the collection" optional:""`
+	sender     mitumbase.Address
+	contract   mitumbase.Address
+	name       types.CollectionName
+	royalty    types.PaymentParameter
+	uri        types.URI
+	whitelist  []mitumbase.Address
+	metadata   types.CollectionMetadata
+	uriPolicy  types.URIPolicy
 }
 
 func (cmd *CreateCollectionCommand) Run(pctx context.Context) error {
@@ -102,6 +108,18 @@ func (cmd *CreateCollectionCommand) parseFlags() error {
 		cmd.whitelist = whitelist
 	}
 
+	metadata, err := cmd.CollectionMetadataFlags.Metadata()
+	if err != nil {
+		return err
+	}
+	cmd.metadata = metadata
+
+	uriPolicy, err := cmd.URIPolicyFlags.URIPolicy()
+	if err != nil {
+		return err
+	}
+	cmd.uriPolicy = uriPolicy
+
 	return nil
 }
 
@@ -116,6 +134,10 @@ func (cmd *CreateCollectionCommand) createOperation() (mitumbase.Operation, erro
 		cmd.royalty,
 		cmd.uri,
 		cmd.whitelist,
+		cmd.Clawback,
+		cmd.UniqueHash,
+		cmd.metadata,
+		cmd.uriPolicy,
 		cmd.Currency.CID,
 	)
 
@@ -44,3 +44,102 @@ func (v *SignerFlag) String() string {
 func (v *SignerFlag) Encode(enc encoder.Encoder) (base.Address, error) {
 	return base.DecodeAddress(v.address, enc)
 }
+
+type AttributeFlag struct {
+	attribute types.Attribute
+}
+
+func (v *AttributeFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 3)
+	if len(l) != 3 {
+		return fmt.Errorf("invalid attribute; %v", string(b))
+	}
+
+	a := types.NewAttribute(l[0], types.AttributeType(l[1]), l[2])
+	if err := a.IsValid(nil); err != nil {
+		return err
+	}
+	v.attribute = a
+
+	return nil
+}
+
+func (v *AttributeFlag) String() string {
+	return fmt.Sprintf("%s,%s,%s", v.attribute.Key(), v.attribute.Type(), v.attribute.Value())
+}
+
+func (v *AttributeFlag) Attribute() types.Attribute {
+	return v.attribute
+}
+
+type CollectionMetadataFlags struct {
+	Symbol       string `name:"symbol" help:"collection symbol" optional:""`
+	Description  string `name:"description" help:"collection description" optional:""`
+	ExternalLink string `name:"external-link" help:"collection external link" optional:""`
+	Image        string `name:"image" help:"collection image uri" optional:""`
+	Banner       string `name:"banner" help:"collection banner uri" optional:""`
+}
+
+func (v *CollectionMetadataFlags) Metadata() (types.CollectionMetadata, error) {
+	metadata := types.NewCollectionMetadata(
+		types.CollectionSymbol(v.Symbol),
+		v.Description,
+		types.URI(v.ExternalLink),
+		types.URI(v.Image),
+		types.URI(v.Banner),
+	)
+	if err := metadata.IsValid(nil); err != nil {
+		return types.CollectionMetadata{}, err
+	}
+
+	return metadata, nil
+}
+
+type URIPolicyFlags struct {
+	URIScheme []string `name:"uri-scheme" help:"allowed nft uri scheme, eg. ipfs, ar, https" optional:""`
+	BaseURI   string   `name:"base-uri" help:"base uri to derive omitted nft uri, \"{base}/{idx}.json\"" optional:""`
+}
+
+func (v *URIPolicyFlags) URIPolicy() (types.URIPolicy, error) {
+	uriPolicy := types.NewURIPolicy(v.URIScheme, types.URI(v.BaseURI))
+	if err := uriPolicy.IsValid(nil); err != nil {
+		return types.URIPolicy{}, err
+	}
+
+	return uriPolicy, nil
+}
+
+type RelayerSignFlag struct {
+	signer    string
+	signature string
+}
+
+func (v *RelayerSignFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) != 2 {
+		return fmt.Errorf("invalid relayer sign; %v", string(b))
+	}
+
+	v.signer = l[0]
+	v.signature = l[1]
+
+	return nil
+}
+
+func (v *RelayerSignFlag) String() string {
+	return fmt.Sprintf("%s,%s", v.signer, v.signature)
+}
+
+func (v *RelayerSignFlag) Encode(enc encoder.Encoder) (types.RelayerSign, error) {
+	signer, err := base.DecodePublickeyFromString(v.signer, enc)
+	if err != nil {
+		return types.RelayerSign{}, err
+	}
+
+	var sig base.Signature
+	if err := sig.UnmarshalText([]byte(v.signature)); err != nil {
+		return types.RelayerSign{}, err
+	}
+
+	return types.NewRelayerSign(signer, sig), nil
+}
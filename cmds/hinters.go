@@ -16,16 +16,31 @@ var SupportedProposalOperationFactHinters []encoder.DecodeDetail
 var AddedHinters = []encoder.DecodeDetail{
 	// revive:disable-next-line:line-length-limit
 	{Hint: types.SignerHint, Instance: types.Signer{}},
+	{Hint: types.AttributeHint, Instance: types.Attribute{}},
 	{Hint: types.SignersHint, Instance: types.Signers{}},
 	{Hint: types.NFTHint, Instance: types.NFT{}},
+	{Hint: types.LegacyNFTHint, Instance: types.NFT{}},
 	{Hint: types.DesignHint, Instance: types.Design{}},
 	{Hint: types.AllApprovedBookHint, Instance: types.AllApprovedBook{}},
+	{Hint: types.CollectionMetadataHint, Instance: types.CollectionMetadata{}},
+	{Hint: types.URIPolicyHint, Instance: types.URIPolicy{}},
+	{Hint: types.ProvenanceHint, Instance: types.Provenance{}},
 	{Hint: types.CollectionPolicyHint, Instance: types.CollectionPolicy{}},
+	{Hint: types.LegacyCollectionPolicyHint, Instance: types.CollectionPolicy{}},
 	{Hint: types.CollectionDesignHint, Instance: types.CollectionDesign{}},
+	{Hint: types.NFTLockHint, Instance: types.NFTLock{}},
+	{Hint: types.CouncilHint, Instance: types.Council{}},
+	{Hint: types.ProposalHint, Instance: types.Proposal{}},
+	{Hint: types.LegacyProposalHint, Instance: types.Proposal{}},
+	{Hint: types.ProposalActionHint, Instance: types.ProposalAction{}},
+	{Hint: types.RelayerSetHint, Instance: types.RelayerSet{}},
+	{Hint: types.RelayerSignHint, Instance: types.RelayerSign{}},
+	{Hint: types.BridgeLockHint, Instance: types.BridgeLock{}},
 
 	{Hint: nft.RegisterModelHint, Instance: nft.RegisterModel{}},
 	{Hint: nft.UpdateModelConfigHint, Instance: nft.UpdateModelConfig{}},
 	{Hint: nft.MintItemHint, Instance: nft.MintItem{}},
+	{Hint: nft.LegacyMintItemHint, Instance: nft.MintItem{}},
 	{Hint: nft.MintHint, Instance: nft.Mint{}},
 	{Hint: nft.TransferItemHint, Instance: nft.TransferItem{}},
 	{Hint: nft.TransferHint, Instance: nft.Transfer{}},
@@ -35,21 +50,73 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: nft.ApproveHint, Instance: nft.Approve{}},
 	{Hint: nft.AddSignatureItemHint, Instance: nft.AddSignatureItem{}},
 	{Hint: nft.AddSignatureHint, Instance: nft.AddSignature{}},
+	{Hint: nft.TransferWithLockItemHint, Instance: nft.TransferWithLockItem{}},
+	{Hint: nft.TransferWithLockHint, Instance: nft.TransferWithLock{}},
+	{Hint: nft.ClaimItemHint, Instance: nft.ClaimItem{}},
+	{Hint: nft.ClaimHint, Instance: nft.Claim{}},
+	{Hint: nft.ForceTransferItemHint, Instance: nft.ForceTransferItem{}},
+	{Hint: nft.ForceTransferHint, Instance: nft.ForceTransfer{}},
+	{Hint: nft.UpdateRestrictionModeHint, Instance: nft.UpdateRestrictionMode{}},
+	{Hint: nft.UpdateRestrictionListHint, Instance: nft.UpdateRestrictionList{}},
+	{Hint: nft.UpdateSponsorsHint, Instance: nft.UpdateSponsors{}},
+	{Hint: nft.GrantRoleHint, Instance: nft.GrantRole{}},
+	{Hint: nft.RevokeRoleHint, Instance: nft.RevokeRole{}},
+	{Hint: nft.RegisterCouncilHint, Instance: nft.RegisterCouncil{}},
+	{Hint: nft.ProposeModelConfigHint, Instance: nft.ProposeModelConfig{}},
+	{Hint: nft.ProposeCollectionActionHint, Instance: nft.ProposeCollectionAction{}},
+	{Hint: nft.ApproveProposalHint, Instance: nft.ApproveProposal{}},
+	{Hint: nft.UnregisterModelHint, Instance: nft.UnregisterModel{}},
+	{Hint: nft.MigrateHint, Instance: nft.Migrate{}},
+	{Hint: nft.UpdateRelayersHint, Instance: nft.UpdateRelayers{}},
+	{Hint: nft.BridgeLockHint, Instance: nft.BridgeLock{}},
+	{Hint: nft.BridgeReleaseHint, Instance: nft.BridgeRelease{}},
 
 	{Hint: state.LastNFTIndexStateValueHint, Instance: state.LastNFTIndexStateValue{}},
 	{Hint: state.NFTStateValueHint, Instance: state.NFTStateValue{}},
 	{Hint: state.OperatorsBookStateValueHint, Instance: state.OperatorsBookStateValue{}},
 	{Hint: state.CollectionStateValueHint, Instance: state.CollectionStateValue{}},
+	{Hint: state.NFTLockStateValueHint, Instance: state.NFTLockStateValue{}},
+	{Hint: state.RestrictionModeStateValueHint, Instance: state.RestrictionModeStateValue{}},
+	{Hint: state.RestrictionStateValueHint, Instance: state.RestrictionStateValue{}},
+	{Hint: state.SponsorStateValueHint, Instance: state.SponsorStateValue{}},
+	{Hint: state.RolesStateValueHint, Instance: state.RolesStateValue{}},
+	{Hint: state.CouncilStateValueHint, Instance: state.CouncilStateValue{}},
+	{Hint: state.ProposalStateValueHint, Instance: state.ProposalStateValue{}},
+	{Hint: state.NFTHashStateValueHint, Instance: state.NFTHashStateValue{}},
+	{Hint: state.CollectionNameStateValueHint, Instance: state.CollectionNameStateValue{}},
+	{Hint: state.RelayerSetStateValueHint, Instance: state.RelayerSetStateValue{}},
+	{Hint: state.BridgeLockStateValueHint, Instance: state.BridgeLockStateValue{}},
+	{Hint: state.RegistrationStateValueHint, Instance: state.RegistrationStateValue{}},
+	{Hint: state.SupplyStateValueHint, Instance: state.SupplyStateValue{}},
 }
 
 var AddedSupportedHinters = []encoder.DecodeDetail{
 	{Hint: nft.RegisterModelFactHint, Instance: nft.RegisterModelFact{}},
 	{Hint: nft.UpdateModelConfigFactHint, Instance: nft.UpdateModelConfigFact{}},
 	{Hint: nft.MintFactHint, Instance: nft.MintFact{}},
+	{Hint: nft.LegacyMintFactHint, Instance: nft.MintFact{}},
 	{Hint: nft.TransferFactHint, Instance: nft.TransferFact{}},
+	{Hint: nft.LegacyTransferFactHint, Instance: nft.TransferFact{}},
 	{Hint: nft.ApproveAllFactHint, Instance: nft.ApproveAllFact{}},
 	{Hint: nft.ApproveFactHint, Instance: nft.ApproveFact{}},
 	{Hint: nft.AddSignatureFactHint, Instance: nft.AddSignatureFact{}},
+	{Hint: nft.TransferWithLockFactHint, Instance: nft.TransferWithLockFact{}},
+	{Hint: nft.ClaimFactHint, Instance: nft.ClaimFact{}},
+	{Hint: nft.ForceTransferFactHint, Instance: nft.ForceTransferFact{}},
+	{Hint: nft.UpdateRestrictionModeFactHint, Instance: nft.UpdateRestrictionModeFact{}},
+	{Hint: nft.UpdateRestrictionListFactHint, Instance: nft.UpdateRestrictionListFact{}},
+	{Hint: nft.UpdateSponsorsFactHint, Instance: nft.UpdateSponsorsFact{}},
+	{Hint: nft.GrantRoleFactHint, Instance: nft.GrantRoleFact{}},
+	{Hint: nft.RevokeRoleFactHint, Instance: nft.RevokeRoleFact{}},
+	{Hint: nft.RegisterCouncilFactHint, Instance: nft.RegisterCouncilFact{}},
+	{Hint: nft.ProposeModelConfigFactHint, Instance: nft.ProposeModelConfigFact{}},
+	{Hint: nft.ProposeCollectionActionFactHint, Instance: nft.ProposeCollectionActionFact{}},
+	{Hint: nft.ApproveProposalFactHint, Instance: nft.ApproveProposalFact{}},
+	{Hint: nft.UnregisterModelFactHint, Instance: nft.UnregisterModelFact{}},
+	{Hint: nft.MigrateFactHint, Instance: nft.MigrateFact{}},
+	{Hint: nft.UpdateRelayersFactHint, Instance: nft.UpdateRelayersFact{}},
+	{Hint: nft.BridgeLockFactHint, Instance: nft.BridgeLockFact{}},
+	{Hint: nft.BridgeReleaseFactHint, Instance: nft.BridgeReleaseFact{}},
 }
 
 func init() {
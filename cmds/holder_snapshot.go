@@ -0,0 +1,118 @@
+package cmds
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-nft/digest"
+	"github.com/pkg/errors"
+)
+
+type HolderSnapshotCommand struct {
+	BaseCommand
+	Digest   string `arg:"" name:"digest" help:"url of digest api" required:"true"`
+	Contract string `arg:"" name:"contract" help:"contract address of collection" required:"true"`
+	Height   int64  `name:"height" help:"height of snapshot; latest height when not given" default:"-1"`
+	Format   string `name:"format" help:"snapshot format; json, csv, ndjson" default:"json"`
+	Output   string `name:"output" help:"file to write snapshot; stdout when not given" optional:""`
+}
+
+// Run streams the holder snapshot from digest api to output without buffering
+// it, so the snapshot of large collection is not limited by the page size. The
+// snapshot without the complete trailer is returned as error, but what is
+// received is kept in output.
+func (cmd *HolderSnapshotCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	format := digest.HolderSnapshotFormat(strings.ToLower(cmd.Format))
+	if err := format.IsValid(nil); err != nil {
+		return err
+	}
+
+	u, err := cmd.snapshotURL(format)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(pctx, http.MethodGet, u, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create holder snapshot request")
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to request holder snapshot")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1<<12))
+
+		return errors.Errorf("failed to get holder snapshot, %v: %s", res.Status, b)
+	}
+
+	out := cmd.Out
+	if len(cmd.Output) > 0 {
+		f, err := os.Create(cmd.Output)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create output file, %v", cmd.Output)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+
+		out = f
+	}
+
+	// NOTE the snapshot is checked while it is written, so the truncated one
+	// is not taken as complete.
+	pr, pw := io.Pipe()
+	checked := make(chan error, 1)
+
+	go func() {
+		err := digest.CheckHolderSnapshot(pr, format)
+		_, _ = io.Copy(io.Discard, pr)
+
+		checked <- err
+	}()
+
+	_, err = io.Copy(io.MultiWriter(out, pw), res.Body)
+	_ = pw.CloseWithError(err)
+
+	if cerr := <-checked; cerr != nil {
+		return errors.Wrap(cerr, "failed to get holder snapshot")
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "failed to write holder snapshot")
+	}
+
+	return nil
+}
+
+func (cmd *HolderSnapshotCommand) snapshotURL(format digest.HolderSnapshotFormat) (string, error) {
+	u, err := url.Parse(strings.TrimRight(cmd.Digest, "/"))
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid digest url, %q", cmd.Digest)
+	}
+
+	u = u.JoinPath("nft", cmd.Contract, "holders")
+
+	q := u.Query()
+	q.Set("format", string(format))
+	if cmd.Height >= 0 {
+		q.Set("height", strconv.FormatInt(cmd.Height, 10))
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
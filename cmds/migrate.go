@@ -0,0 +1,95 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type MigrateCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address; nft owner" required:"true"`
+	Source   currencycmds.AddressFlag    `arg:"" name:"source" help:"source contract address" required:"true"`
+	Target   currencycmds.AddressFlag    `arg:"" name:"target" help:"target contract address" required:"true"`
+	NFT      uint64                      `arg:"" name:"nft" help:"target nft in source collection"`
+	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	source   base.Address
+	target   base.Address
+}
+
+func (cmd *MigrateCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *MigrateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Source.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid source contract address format, %v", cmd.Source)
+	} else {
+		cmd.source = a
+	}
+
+	if a, err := cmd.Target.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid target contract address format, %v", cmd.Target)
+	} else {
+		cmd.target = a
+	}
+
+	return nil
+}
+
+// createOperation signs the operation as the nft owner; the creators of the
+// source and target collections should sign it before it is sent.
+func (cmd *MigrateCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create migrate operation")
+
+	fact := nft.NewMigrateFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.source,
+		cmd.target,
+		cmd.NFT,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewMigrate(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
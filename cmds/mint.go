@@ -14,19 +14,24 @@ import (
 type MintCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
-	Receiver currencycmds.AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:"true"`
-	Hash     string                      `arg:"" name:"hash" help:"nft hash" required:"true"`
-	Uri      string                      `arg:"" name:"uri" help:"nft uri" required:"true"`
-	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	Creator  SignerFlag                  `name:"creator" help:"nft contents creator \"<address>,<share>\"" optional:""`
-	sender   base.Address
-	contract base.Address
-	receiver base.Address
-	hash     types.NFTHash
-	uri      types.URI
-	creators types.Signers
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Receiver    currencycmds.AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Hash        string                      `arg:"" name:"hash" help:"nft hash" required:"true"`
+	Uri         string                      `arg:"" name:"uri" help:"nft uri; empty to derive it from base uri of collection"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Creator     SignerFlag                  `name:"creator" help:"nft contents creator \"<address>,<share>\"" optional:""`
+	Attribute   []AttributeFlag             `name:"attribute" help:"nft attribute \"<key>,<string|integer|boolean>,<value>\"" optional:""`
+	FeePayer    currencycmds.AddressFlag    `name:"fee-payer" help:"sponsor address paying fee instead of sender" optional:""`
+	FeePayerKey string                      `name:"fee-payer-key" help:"fee payer privatekey to sign operation" optional:""`
+	sender      base.Address
+	contract    base.Address
+	receiver    base.Address
+	feePayer    base.Address
+	hash        types.NFTHash
+	uri         types.URI
+	creators    types.Signers
+	attributes  []types.Attribute
 }
 
 func (cmd *MintCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -77,6 +82,8 @@ func (cmd *MintCommand) parseFlags() error {
 	hash := types.NFTHash(cmd.Hash)
 	if err := hash.IsValid(nil); err != nil {
 		return err
+	} else if err := hash.IsValidTyped(); err != nil {
+		return err
 	} else {
 		cmd.hash = hash
 	}
@@ -110,6 +117,25 @@ func (cmd *MintCommand) parseFlags() error {
 		cmd.creators = creators
 	}
 
+	attributes := make([]types.Attribute, len(cmd.Attribute))
+	for i := range cmd.Attribute {
+		attributes[i] = cmd.Attribute[i].Attribute()
+	}
+
+	if err := types.IsValidAttributes(attributes); err != nil {
+		return err
+	} else {
+		cmd.attributes = attributes
+	}
+
+	if len(cmd.FeePayer.String()) > 0 {
+		if a, err := cmd.FeePayer.Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid fee payer address format, %v", cmd.FeePayer.String())
+		} else {
+			cmd.feePayer = a
+		}
+	}
+
 	return nil
 
 }
@@ -117,8 +143,8 @@ func (cmd *MintCommand) parseFlags() error {
 func (cmd *MintCommand) createOperation() (base.Operation, error) { // nolint:dupl
 	e := util.StringError("failed to create mint operation")
 
-	item := nft.NewMintItem(cmd.contract, cmd.receiver, cmd.hash, cmd.uri, cmd.creators, cmd.Currency.CID)
-	fact := nft.NewMintFact([]byte(cmd.Token), cmd.sender, []nft.MintItem{item})
+	item := nft.NewMintItem(cmd.contract, cmd.receiver, cmd.hash, cmd.uri, cmd.creators, cmd.attributes, cmd.Currency.CID)
+	fact := nft.NewMintFact([]byte(cmd.Token), cmd.sender, []nft.MintItem{item}, cmd.feePayer)
 
 	op, err := nft.NewMint(fact)
 	if err != nil {
@@ -129,5 +155,16 @@ func (cmd *MintCommand) createOperation() (base.Operation, error) { // nolint:du
 		return nil, e.Wrap(err)
 	}
 
+	if len(cmd.FeePayerKey) > 0 {
+		priv, err := base.DecodePrivatekeyFromString(cmd.FeePayerKey, cmd.Encoders.JSON())
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		if err := op.Sign(priv, cmd.NetworkID.NetworkID()); err != nil {
+			return nil, e.Wrap(err)
+		}
+	}
+
 	return op, nil
 }
@@ -1,11 +1,30 @@
 package cmds
 
 type NFTCommand struct {
-	CreateCollection       CreateCollectionCommand       `cmd:"" name:"create-collection" help:"register new collection design"`
-	UpdateCollectionPolicy UpdateCollectionPolicyCommand `cmd:"" name:"update-collection-policy" help:"update collection design"`
-	Mint                   MintCommand                   `cmd:"" name:"mint" help:"mint new nft to collection"`
-	Transfer               TransferCommand               `cmd:"" name:"transfer" help:"transfer nfts to receiver"`
-	Delegate               DelegateCommand               `cmd:"" name:"delegate" help:"delegate operator or cancel operator delegation"`
-	Approve                ApproveCommand                `cmd:"" name:"approve" help:"approve account for nft"`
-	Sign                   SignCommand                   `cmd:"" name:"sign" help:"sign nft as creator | copyrighter"`
+	CreateCollection       CreateCollectionCommand        `cmd:"" name:"create-collection" help:"register new collection design"`
+	UpdateCollectionPolicy UpdateCollectionPolicyCommand  `cmd:"" name:"update-collection-policy" help:"update collection design"`
+	Mint                   MintCommand                    `cmd:"" name:"mint" help:"mint new nft to collection"`
+	Transfer               TransferCommand                `cmd:"" name:"transfer" help:"transfer nfts to receiver"`
+	Delegate               DelegateCommand                `cmd:"" name:"delegate" help:"delegate operator or cancel operator delegation"`
+	Approve                ApproveCommand                 `cmd:"" name:"approve" help:"approve account for nft"`
+	Sign                   SignCommand                    `cmd:"" name:"sign" help:"sign nft as creator | copyrighter"`
+	TransferWithLock       TransferWithLockCommand        `cmd:"" name:"transfer-with-lock" help:"transfer nft to receiver with time lock"`
+	Claim                  ClaimCommand                   `cmd:"" name:"claim" help:"claim locked nft or cancel nft lock"`
+	ForceTransfer          ForceTransferCommand           `cmd:"" name:"force-transfer" help:"force transfer nft as collection creator"`
+	UpdateRestrictionMode  UpdateRestrictionModeCommand   `cmd:"" name:"update-restriction-mode" help:"update receiver restriction mode of collection"`
+	UpdateRestrictionList  UpdateRestrictionListCommand   `cmd:"" name:"update-restriction-list" help:"add or remove accounts in receiver restriction list of collection"`
+	UpdateSponsors         UpdateSponsorsCommand          `cmd:"" name:"update-sponsors" help:"add or remove fee sponsors of collection"`
+	GrantRole              GrantRoleCommand               `cmd:"" name:"grant-role" help:"grant collection role to account"`
+	RevokeRole             RevokeRoleCommand              `cmd:"" name:"revoke-role" help:"revoke collection role from account"`
+	RegisterCouncil        RegisterCouncilCommand         `cmd:"" name:"register-council" help:"register council governing collection policy"`
+	ProposeModelConfig     ProposeModelConfigCommand      `cmd:"" name:"propose-model-config" help:"propose collection policy change to council"`
+	ProposeAction          ProposeCollectionActionCommand `cmd:"" name:"propose-collection-action" help:"propose roles, sponsors or restriction list change to council"`
+	ApproveProposal        ApproveProposalCommand         `cmd:"" name:"approve-proposal" help:"approve collection proposal as council member"`
+	UnregisterCollection   UnregisterCollectionCommand    `cmd:"" name:"unregister-collection" help:"unregister empty collection as collection creator"`
+	Migrate                MigrateCommand                 `cmd:"" name:"migrate" help:"migrate nft to other collection with consent of both collection creators"`
+	UpdateRelayers         UpdateRelayersCommand          `cmd:"" name:"update-relayers" help:"update bridge relayer keys of collection as collection creator"`
+	BridgeLock             BridgeLockCommand              `cmd:"" name:"bridge-lock" help:"lock nft in bridge vault to bridge it to remote chain"`
+	BridgeRelease          BridgeReleaseCommand           `cmd:"" name:"bridge-release" help:"release bridged nft with relayer signs"`
+	VerifyNFTHash          VerifyNFTHashCommand           `cmd:"" name:"verify-hash" help:"compute typed hash of local file and verify it against nft hash"`
+	HolderSnapshot         HolderSnapshotCommand          `cmd:"" name:"holder-snapshot" help:"export holder snapshot of collection at height from digest api"`
 }
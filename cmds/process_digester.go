@@ -33,6 +33,19 @@ func ProcessDigester(ctx context.Context) (context.Context, error) {
 		return ctx, nil
 	}
 
+	if err := digest.CreateNFTIndexes(ctx, st); err != nil {
+		return ctx, err
+	}
+
+	// NOTE the nfts digested before nft history is kept are backfilled before
+	// new blocks are digested, or their versions are lost at the next change.
+	switch n, err := digest.BackfillNFTHistory(ctx, st); {
+	case err != nil:
+		return ctx, err
+	case n > 0:
+		log.Log().Info().Int("nfts", n).Msg("nft history backfilled")
+	}
+
 	var design launch.NodeDesign
 	if err := util.LoadFromContext(ctx, launch.DesignContextKey, &design); err != nil {
 		return ctx, err
@@ -0,0 +1,139 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type ProposeCollectionActionCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract     currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Action       string                      `arg:"" name:"action" help:"proposal action; grant-role | revoke-role | add-sponsors | remove-sponsors | add-restriction | remove-restriction | update-restriction-mode | update-relayers" required:"true"`
+	ExpireHeight int64                       `arg:"" name:"expire-height" help:"height from which the proposal is rejected unless approved" required:"true"`
+	Currency     currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Account      []currencycmds.AddressFlag  `name:"account" help:"account address of role, sponsors and restriction actions" optional:""`
+	Role         string                      `name:"role" help:"role of grant-role and revoke-role; admin | minter | metadata-editor" optional:""`
+	Mode         string                      `name:"mode" help:"restriction mode of update-restriction-mode; none | deny | allow" optional:""`
+	RelayerKey   []string                    `name:"relayer-key" help:"relayer publickey of update-relayers" optional:""`
+	Threshold    uint                        `name:"threshold" help:"number of relayer signs of update-relayers" optional:""`
+	sender       mitumbase.Address
+	contract     mitumbase.Address
+	action       types.ProposalAction
+	expireHeight mitumbase.Height
+}
+
+func (cmd *ProposeCollectionActionCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ProposeCollectionActionCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	action, err := cmd.parseAction()
+	if err != nil {
+		return err
+	}
+
+	if err := action.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.action = action
+
+	expireHeight := mitumbase.Height(cmd.ExpireHeight)
+	if err := expireHeight.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.expireHeight = expireHeight
+
+	return nil
+}
+
+func (cmd *ProposeCollectionActionCommand) parseAction() (types.ProposalAction, error) {
+	switch kind := types.ProposalActionKind(cmd.Action); kind {
+	case types.ProposalActionRestrictionMode:
+		return types.NewRestrictionModeProposalAction(types.RestrictionMode(cmd.Mode)), nil
+	case types.ProposalActionRelayers:
+		keys := make([]mitumbase.Publickey, len(cmd.RelayerKey))
+		for i := range cmd.RelayerKey {
+			k, err := mitumbase.DecodePublickeyFromString(cmd.RelayerKey[i], cmd.Encoders.JSON())
+			if err != nil {
+				return types.ProposalAction{}, errors.Wrapf(err, "invalid relayer publickey format, %v", cmd.RelayerKey[i])
+			}
+			keys[i] = k
+		}
+
+		return types.NewRelayersProposalAction(types.NewRelayerSet(keys, cmd.Threshold)), nil
+	default:
+		accounts := make([]mitumbase.Address, len(cmd.Account))
+		for i := range cmd.Account {
+			a, err := cmd.Account[i].Encode(cmd.Encoders.JSON())
+			if err != nil {
+				return types.ProposalAction{}, errors.Wrapf(err, "invalid account address format, %v", cmd.Account[i])
+			}
+			accounts[i] = a
+		}
+
+		return types.NewProposalAction(kind, types.Role(cmd.Role), accounts), nil
+	}
+}
+
+func (cmd *ProposeCollectionActionCommand) createOperation() (mitumbase.Operation, error) {
+	e := util.StringError("failed to create propose-collection-action operation")
+
+	fact := nft.NewProposeCollectionActionFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.action,
+		cmd.expireHeight,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewProposeCollectionAction(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
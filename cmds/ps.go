@@ -5,6 +5,7 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	currencyprocessor "github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum-nft/operation/nft"
 	"github.com/ProtoconNet/mitum-nft/operation/processor"
 	"github.com/ProtoconNet/mitum2/base"
@@ -40,41 +41,133 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 	if err != nil {
 		return pctx, err
 	}
-	if err := opr.SetProcessor(
+
+	// NOTE every nft processor sees only the states of the latest registration
+	// of collection.
+	setNFTProcessor := func(ht hint.Hint, f currencytypes.GetNewProcessor) error {
+		return opr.SetProcessor(ht, nft.NewRegisteredProcessor(f))
+	}
+
+	if err := setNFTProcessor(
 		nft.RegisterModelHint,
 		nft.NewRegisterModelProcessor(),
 	); err != nil {
 		return pctx, err
-	} else if err := opr.SetProcessor(
+	} else if err := setNFTProcessor(
 		nft.UpdateModelConfigHint,
 		nft.NewUpdateModelConfigProcessor(),
 	); err != nil {
 		return pctx, err
-	} else if err := opr.SetProcessor(
+	} else if err := setNFTProcessor(
 		nft.MintHint,
 		nft.NewMintProcessor(),
 	); err != nil {
 		return pctx, err
-	} else if err := opr.SetProcessor(
+	} else if err := setNFTProcessor(
 		nft.TransferHint,
 		nft.NewTransferProcessor(),
 	); err != nil {
 		return pctx, err
-	} else if err := opr.SetProcessor(
+	} else if err := setNFTProcessor(
 		nft.ApproveAllHint,
 		nft.NewDelegateProcessor(),
 	); err != nil {
 		return pctx, err
-	} else if err := opr.SetProcessor(
+	} else if err := setNFTProcessor(
 		nft.ApproveHint,
 		nft.NewApproveProcessor(),
 	); err != nil {
 		return pctx, err
-	} else if err := opr.SetProcessor(
+	} else if err := setNFTProcessor(
 		nft.AddSignatureHint,
 		nft.NewSignProcessor(),
 	); err != nil {
 		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.TransferWithLockHint,
+		nft.NewTransferWithLockProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.ClaimHint,
+		nft.NewClaimProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.ForceTransferHint,
+		nft.NewForceTransferProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.UpdateRestrictionModeHint,
+		nft.NewUpdateRestrictionModeProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.UpdateRestrictionListHint,
+		nft.NewUpdateRestrictionListProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.UpdateSponsorsHint,
+		nft.NewUpdateSponsorsProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.GrantRoleHint,
+		nft.NewGrantRoleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.RevokeRoleHint,
+		nft.NewRevokeRoleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.RegisterCouncilHint,
+		nft.NewRegisterCouncilProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.ProposeModelConfigHint,
+		nft.NewProposeModelConfigProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.ProposeCollectionActionHint,
+		nft.NewProposeCollectionActionProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.ApproveProposalHint,
+		nft.NewApproveProposalProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.UnregisterModelHint,
+		nft.NewUnregisterModelProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.MigrateHint,
+		nft.NewMigrateProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.UpdateRelayersHint,
+		nft.NewUpdateRelayersProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.BridgeLockHint,
+		nft.NewBridgeLockProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := setNFTProcessor(
+		nft.BridgeReleaseHint,
+		nft.NewBridgeReleaseProcessor(isaacParams.NetworkID()),
+	); err != nil {
+		return pctx, err
 	}
 
 	_ = set.Add(nft.RegisterModelHint,
@@ -147,6 +240,176 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 			)
 		})
 
+	_ = set.Add(nft.TransferWithLockHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.ClaimHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.ForceTransferHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.UpdateRestrictionModeHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.UpdateRestrictionListHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.UpdateSponsorsHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.GrantRoleHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.RevokeRoleHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.RegisterCouncilHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.ProposeModelConfigHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.ProposeCollectionActionHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.ApproveProposalHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.UnregisterModelHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.MigrateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.UpdateRelayersHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.BridgeLockHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(nft.BridgeReleaseHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
 	pctx = context.WithValue(pctx, currencycmds.OperationProcessorContextKey, opr)
 	pctx = context.WithValue(pctx, launch.OperationProcessorsMapContextKey, set) //revive:disable-line:modifies-parameter
 
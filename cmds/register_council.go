@@ -0,0 +1,97 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type RegisterCouncilCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender    currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Threshold uint                        `arg:"" name:"threshold" help:"number of approvals to apply a proposal" required:"true"`
+	Currency  currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Member    []currencycmds.AddressFlag  `name:"member" help:"council member address" required:"true"`
+	sender    base.Address
+	contract  base.Address
+	members   []base.Address
+}
+
+func (cmd *RegisterCouncilCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RegisterCouncilCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	members := make([]base.Address, len(cmd.Member))
+	for i := range cmd.Member {
+		a, err := cmd.Member[i].Encode(cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid member address format, %v", cmd.Member[i])
+		}
+		members[i] = a
+	}
+	cmd.members = members
+
+	return nil
+}
+
+func (cmd *RegisterCouncilCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create register-council operation")
+
+	fact := nft.NewRegisterCouncilFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.members,
+		cmd.Threshold,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewRegisterCouncil(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
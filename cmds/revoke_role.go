@@ -0,0 +1,101 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum-nft/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type RevokeRoleCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Account  currencycmds.AddressFlag    `arg:"" name:"account" help:"account address to be revoked" required:"true"`
+	Role     string                      `arg:"" name:"role" help:"role; admin | minter | metadata-editor" required:"true"`
+	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+	account  base.Address
+	role     types.Role
+}
+
+func (cmd *RevokeRoleCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RevokeRoleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	if a, err := cmd.Account.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid account address format, %v", cmd.Account)
+	} else {
+		cmd.account = a
+	}
+
+	role := types.Role(cmd.Role)
+	if err := role.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.role = role
+
+	return nil
+}
+
+func (cmd *RevokeRoleCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create revoke-role operation")
+
+	fact := nft.NewRevokeRoleFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.account,
+		cmd.role,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewRevokeRole(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
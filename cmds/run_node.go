@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
@@ -34,6 +35,7 @@ type RunCommand struct { //nolint:govet //...
 	Discovery []launch.ConnInfoFlag `help:"member discovery" placeholder:"ConnInfo"`
 	Hold      launch.HeightFlag     `help:"hold consensus states"`
 	HTTPState string                `name:"http-state" help:"runtime statistics thru https" placeholder:"bind address"`
+	StatsTTL  time.Duration         `name:"digest-stats-ttl" help:"cache duration of nft collection stats in digest" default:"30s"`
 	launch.ACLFlags
 	exitf  func(error)
 	log    *zerolog.Logger
@@ -53,6 +55,7 @@ func (cmd *RunCommand) Run(pctx context.Context) error {
 		Interface("discovery", cmd.Discovery).
 		Interface("hold", cmd.Hold).
 		Interface("http_state", cmd.HTTPState).
+		Interface("digest_stats_ttl", cmd.StatsTTL).
 		Interface("dev", cmd.DevFlags).
 		Interface("acl", cmd.ACLFlags).
 		Msg("flags")
@@ -481,7 +484,8 @@ func (cmd *RunCommand) setDigestHandlers(
 		return nil, err
 	}
 
-	handlers := digest.NewHandlers(ctx, params.ISAAC.NetworkID(), encs, enc, st, cache, router, routes)
+	handlers := digest.NewHandlers(ctx, params.ISAAC.NetworkID(), encs, enc, st, cache, router, routes).
+		SetStatsExpire(cmd.StatsTTL)
 
 	return handlers, nil
 }
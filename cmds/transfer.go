@@ -14,15 +14,18 @@ import (
 type TransferCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Receiver   currencycmds.AddressFlag    `arg:"" name:"receiver" help:"nft owner" required:"true"`
-	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
-	NFT        uint64                      `arg:"" name:"nft" help:"target nft"`
-	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	sender     base.Address
-	receiver   base.Address
-	contract   base.Address
-	collection types.ContractID
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver    currencycmds.AddressFlag    `arg:"" name:"receiver" help:"nft owner" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	NFT         uint64                      `arg:"" name:"nft" help:"target nft"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	FeePayer    currencycmds.AddressFlag    `name:"fee-payer" help:"sponsor address paying fee instead of sender" optional:""`
+	FeePayerKey string                      `name:"fee-payer-key" help:"fee payer privatekey to sign operation" optional:""`
+	sender      base.Address
+	receiver    base.Address
+	contract    base.Address
+	feePayer    base.Address
+	collection  types.ContractID
 }
 
 func (cmd *TransferCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -67,6 +70,14 @@ func (cmd *TransferCommand) parseFlags() error {
 		cmd.contract = a
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		if a, err := cmd.FeePayer.Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid fee payer address format, %v", cmd.FeePayer.String())
+		} else {
+			cmd.feePayer = a
+		}
+	}
+
 	return nil
 
 }
@@ -79,6 +90,7 @@ func (cmd *TransferCommand) createOperation() (base.Operation, error) {
 		[]byte(cmd.Token),
 		cmd.sender,
 		[]nft.TransferItem{item},
+		cmd.feePayer,
 	)
 
 	op, err := nft.NewTransfer(fact)
@@ -90,5 +102,16 @@ func (cmd *TransferCommand) createOperation() (base.Operation, error) {
 		return nil, e.Wrap(err)
 	}
 
+	if len(cmd.FeePayerKey) > 0 {
+		priv, err := base.DecodePrivatekeyFromString(cmd.FeePayerKey, cmd.Encoders.JSON())
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		if err := op.Sign(priv, cmd.NetworkID.NetworkID()); err != nil {
+			return nil, e.Wrap(err)
+		}
+	}
+
 	return op, nil
 }
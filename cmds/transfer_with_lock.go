@@ -0,0 +1,93 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type TransferWithLockCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender        currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver      currencycmds.AddressFlag    `arg:"" name:"receiver" help:"nft receiver" required:"true"`
+	Contract      currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	NFT           uint64                      `arg:"" name:"nft" help:"target nft"`
+	ReleaseHeight int64                       `arg:"" name:"release-height" help:"block height from which receiver can claim nft"`
+	Currency      currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender        base.Address
+	receiver      base.Address
+	contract      base.Address
+}
+
+func (cmd *TransferWithLockCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *TransferWithLockCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender.String())
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Receiver.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %v", cmd.Receiver.String())
+	} else {
+		cmd.receiver = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract.String())
+	} else {
+		cmd.contract = a
+	}
+
+	return nil
+}
+
+func (cmd *TransferWithLockCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create transfer-with-lock operation")
+
+	item := nft.NewTransferWithLockItem(
+		cmd.contract, cmd.receiver, cmd.NFT, base.Height(cmd.ReleaseHeight), cmd.Currency.CID)
+	fact := nft.NewTransferWithLockFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]nft.TransferWithLockItem{item},
+	)
+
+	op, err := nft.NewTransferWithLock(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
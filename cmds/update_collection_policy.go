@@ -14,19 +14,23 @@ import (
 type UpdateCollectionPolicyCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
-	Name     string                      `arg:"" name:"name" help:"collection name" required:"true"`
-	Royalty  uint                        `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
-	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	URI      string                      `name:"uri" help:"collection uri" optional:""`
-	White    currencycmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
-	sender   mitumbase.Address
-	contract mitumbase.Address
-	name     types.CollectionName
-	royalty  types.PaymentParameter
-	uri      types.URI
-	white    []mitumbase.Address
+	CollectionMetadataFlags
+	URIPolicyFlags
+	Sender    currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Name      string                      `arg:"" name:"name" help:"collection name" required:"true"`
+	Royalty   uint                        `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
+	Currency  currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	URI       string                      `name:"uri" help:"collection uri" optional:""`
+	White     currencycmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
+	sender    mitumbase.Address
+	contract  mitumbase.Address
+	name      types.CollectionName
+	royalty   types.PaymentParameter
+	uri       types.URI
+	white     []mitumbase.Address
+	metadata  types.CollectionMetadata
+	uriPolicy types.URIPolicy
 }
 
 func (cmd *UpdateCollectionPolicyCommand) Run(pctx context.Context) error {
@@ -94,6 +98,18 @@ func (cmd *UpdateCollectionPolicyCommand) parseFlags() error {
 		cmd.uri = uri
 	}
 
+	metadata, err := cmd.CollectionMetadataFlags.Metadata()
+	if err != nil {
+		return err
+	}
+	cmd.metadata = metadata
+
+	uriPolicy, err := cmd.URIPolicyFlags.URIPolicy()
+	if err != nil {
+		return err
+	}
+	cmd.uriPolicy = uriPolicy
+
 	return nil
 }
 
@@ -108,6 +124,8 @@ func (cmd *UpdateCollectionPolicyCommand) createOperation() (mitumbase.Operation
 		cmd.royalty,
 		cmd.uri,
 		cmd.white,
+		cmd.metadata,
+		cmd.uriPolicy,
 		cmd.Currency.CID,
 	)
 
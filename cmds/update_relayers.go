@@ -0,0 +1,97 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type UpdateRelayersCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender    currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Threshold uint                        `arg:"" name:"threshold" help:"number of relayer signs to release bridged nft" required:"true"`
+	Currency  currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Key       []string                    `name:"key" help:"relayer publickey" required:"true"`
+	sender    base.Address
+	contract  base.Address
+	keys      []base.Publickey
+}
+
+func (cmd *UpdateRelayersCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateRelayersCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	keys := make([]base.Publickey, len(cmd.Key))
+	for i := range cmd.Key {
+		k, err := base.DecodePublickeyFromString(cmd.Key[i], cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid relayer publickey format, %v", cmd.Key[i])
+		}
+		keys[i] = k
+	}
+	cmd.keys = keys
+
+	return nil
+}
+
+func (cmd *UpdateRelayersCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create update-relayers operation")
+
+	fact := nft.NewUpdateRelayersFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.keys,
+		cmd.Threshold,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewUpdateRelayers(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type UpdateRestrictionListCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Account  currencycmds.AddressFlag    `arg:"" name:"account" help:"restricted account address" required:"true"`
+	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Action   string                      `name:"action" help:"restriction list action; add | remove" optional:""`
+	sender   base.Address
+	contract base.Address
+	account  base.Address
+	action   nft.RestrictionListAction
+}
+
+func (cmd *UpdateRestrictionListCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateRestrictionListCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	if a, err := cmd.Account.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid account address format, %v", cmd.Account)
+	} else {
+		cmd.account = a
+	}
+
+	if len(cmd.Action) < 1 {
+		cmd.action = nft.RestrictionListAdd
+	} else {
+		action := nft.RestrictionListAction(cmd.Action)
+		if err := action.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.action = action
+	}
+
+	return nil
+}
+
+func (cmd *UpdateRestrictionListCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create update-restriction-list operation")
+
+	fact := nft.NewUpdateRestrictionListFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.action,
+		[]base.Address{cmd.account},
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewUpdateRestrictionList(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
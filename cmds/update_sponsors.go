@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type UpdateSponsorsCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Account  currencycmds.AddressFlag    `arg:"" name:"account" help:"sponsor account address" required:"true"`
+	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Action   string                      `name:"action" help:"sponsor list action; add | remove" optional:""`
+	sender   base.Address
+	contract base.Address
+	account  base.Address
+	action   nft.SponsorListAction
+}
+
+func (cmd *UpdateSponsorsCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateSponsorsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	if a, err := cmd.Account.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid account address format, %v", cmd.Account)
+	} else {
+		cmd.account = a
+	}
+
+	if len(cmd.Action) < 1 {
+		cmd.action = nft.SponsorListAdd
+	} else {
+		action := nft.SponsorListAction(cmd.Action)
+		if err := action.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.action = action
+	}
+
+	return nil
+}
+
+func (cmd *UpdateSponsorsCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create update-sponsors operation")
+
+	fact := nft.NewUpdateSponsorsFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.action,
+		[]base.Address{cmd.account},
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewUpdateSponsors(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,105 @@
+package cmds
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/ProtoconNet/mitum-nft/types"
+	"github.com/pkg/errors"
+)
+
+type VerifyNFTHashCommand struct {
+	BaseCommand
+	File      string `arg:"" name:"file" help:"local file of nft contents" required:"true"`
+	Hash      string `name:"hash" help:"typed nft hash to check, \"<algorithm>:<digest>\"" optional:""`
+	NFT       string `name:"nft" help:"json file of on-chain nft, as responded by digest api" optional:""`
+	Algorithm string `name:"algorithm" help:"hash algorithm when nothing to check; sha256, sha3-256, blake2b-256" default:"sha256"`
+}
+
+func (cmd *VerifyNFTHashCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	expected, err := cmd.expectedHash()
+	if err != nil {
+		return err
+	}
+
+	algo := types.HashAlgorithm(cmd.Algorithm)
+	if len(expected) > 0 {
+		a, _, ok := expected.Typed()
+		if !ok {
+			return errors.Errorf("nft hash %q is not typed, can not be verified", expected)
+		}
+		algo = a
+	}
+
+	if err := algo.IsValid(nil); err != nil {
+		return err
+	}
+
+	f, err := os.Open(cmd.File)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open file, %v", cmd.File)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	computed, err := algo.Sum(f)
+	if err != nil {
+		return err
+	}
+
+	if len(expected) < 1 {
+		cmd.print("%s", computed)
+
+		return nil
+	}
+
+	if computed != expected {
+		return errors.Errorf("nft hash mismatch; computed %q, expected %q", computed, expected)
+	}
+
+	cmd.print("verified %s", computed)
+
+	return nil
+}
+
+func (cmd *VerifyNFTHashCommand) expectedHash() (types.NFTHash, error) {
+	switch {
+	case len(cmd.Hash) > 0 && len(cmd.NFT) > 0:
+		return "", errors.Errorf("hash and nft can not be given together")
+	case len(cmd.Hash) > 0:
+		return types.NFTHash(cmd.Hash), nil
+	case len(cmd.NFT) > 0:
+	default:
+		return "", nil
+	}
+
+	b, err := os.ReadFile(cmd.NFT)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read nft file, %v", cmd.NFT)
+	}
+
+	var hal struct {
+		Embedded json.RawMessage `json:"_embedded"`
+	}
+	if err := json.Unmarshal(b, &hal); err == nil && len(hal.Embedded) > 0 {
+		b = hal.Embedded
+	}
+
+	hinter, err := cmd.Encoder.Decode(b)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode nft")
+	}
+
+	nft, ok := hinter.(types.NFT)
+	if !ok {
+		return "", errors.Errorf("expected NFT, not %T", hinter)
+	}
+
+	return nft.NFTHash(), nil
+}
@@ -32,27 +32,35 @@ type BlockSessioner interface {
 
 type BlockSession struct {
 	sync.RWMutex
-	block                 mitumbase.BlockMap
-	ops                   []mitumbase.Operation
-	opstree               fixedtree.Tree
-	sts                   []mitumbase.State
-	st                    *currencydigest.Database
-	proposal              mitumbase.ProposalSignFact
-	opsTreeNodes          map[string]mitumbase.OperationFixedtreeNode
-	blockModels           []mongo.WriteModel
-	operationModels       []mongo.WriteModel
-	accountModels         []mongo.WriteModel
-	balanceModels         []mongo.WriteModel
-	currencyModels        []mongo.WriteModel
-	contractAccountModels []mongo.WriteModel
-	nftCollectionModels   []mongo.WriteModel
-	nftModels             []mongo.WriteModel
-	nftBoxModels          []mongo.WriteModel
-	nftOperatorModels     []mongo.WriteModel
-	statesValue           *sync.Map
-	balanceAddressList    []string
-	nftMap                map[string]struct{}
-	buildinfo             string
+	block                    mitumbase.BlockMap
+	ops                      []mitumbase.Operation
+	opstree                  fixedtree.Tree
+	sts                      []mitumbase.State
+	st                       *currencydigest.Database
+	proposal                 mitumbase.ProposalSignFact
+	opsTreeNodes             map[string]mitumbase.OperationFixedtreeNode
+	blockModels              []mongo.WriteModel
+	operationModels          []mongo.WriteModel
+	accountModels            []mongo.WriteModel
+	balanceModels            []mongo.WriteModel
+	currencyModels           []mongo.WriteModel
+	contractAccountModels    []mongo.WriteModel
+	nftCollectionModels      []mongo.WriteModel
+	nftModels                []mongo.WriteModel
+	nftHistoryModels         []mongo.WriteModel
+	nftLastIndexModels       []mongo.WriteModel
+	nftOperatorModels        []mongo.WriteModel
+	nftRestrictionModeModels []mongo.WriteModel
+	nftRestrictionModels     []mongo.WriteModel
+	nftCouncilModels         []mongo.WriteModel
+	nftProposalModels        []mongo.WriteModel
+	nftHashModels            []mongo.WriteModel
+	nftCollectionNameModels  []mongo.WriteModel
+	statesValue              *sync.Map
+	balanceAddressList       []string
+	nftMap                   map[string]struct{}
+	forcedFacts              map[string]struct{}
+	buildinfo                string
 }
 
 func NewBlockSession(
@@ -184,14 +192,56 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 			}
 		}
 
+		if len(bs.nftHistoryModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTHistory, bs.nftHistoryModels); err != nil {
+				return nil, err
+			}
+		}
+
 		if len(bs.nftOperatorModels) > 0 {
 			if err := bs.writeModels(txnCtx, defaultColNameNFTOperator, bs.nftOperatorModels); err != nil {
 				return nil, err
 			}
 		}
 
-		if len(bs.nftBoxModels) > 0 {
-			if err := bs.writeModels(txnCtx, defaultColNameNFT, bs.nftBoxModels); err != nil {
+		if len(bs.nftRestrictionModeModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTRestrictionMode, bs.nftRestrictionModeModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.nftRestrictionModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTRestriction, bs.nftRestrictionModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.nftCouncilModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTCouncil, bs.nftCouncilModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.nftProposalModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTProposal, bs.nftProposalModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.nftHashModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTHash, bs.nftHashModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.nftCollectionNameModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTCollectionName, bs.nftCollectionNameModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.nftLastIndexModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameNFTLastIndex, bs.nftLastIndexModels); err != nil {
 				return nil, err
 			}
 		}
@@ -429,7 +479,15 @@ func (bs *BlockSession) close() error {
 	bs.contractAccountModels = nil
 	bs.nftCollectionModels = nil
 	bs.nftModels = nil
+	bs.nftHistoryModels = nil
+	bs.nftLastIndexModels = nil
 	bs.nftOperatorModels = nil
+	bs.nftRestrictionModeModels = nil
+	bs.nftRestrictionModels = nil
+	bs.nftCouncilModels = nil
+	bs.nftProposalModels = nil
+	bs.nftHashModels = nil
+	bs.nftCollectionNameModels = nil
 
 	return bs.st.Close()
 }
@@ -1,8 +1,13 @@
 package digest
 
 import (
+	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-nft/operation/nft"
 	"github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -13,8 +18,15 @@ func (bs *BlockSession) prepareNFTs() error {
 
 	var nftCollectionModels []mongo.WriteModel
 	var nftOperatorModels []mongo.WriteModel
-	var nftBoxModels []mongo.WriteModel
+	var nftLastIndexModels []mongo.WriteModel
 	var nftModels []mongo.WriteModel
+	var nftHistoryModels []mongo.WriteModel
+	var nftRestrictionModeModels []mongo.WriteModel
+	var nftRestrictionModels []mongo.WriteModel
+	var nftCouncilModels []mongo.WriteModel
+	var nftProposalModels []mongo.WriteModel
+	var nftHashModels []mongo.WriteModel
+	var nftCollectionNameModels []mongo.WriteModel
 
 	for i := range bs.sts {
 		st := bs.sts[i]
@@ -29,6 +41,12 @@ func (bs *BlockSession) prepareNFTs() error {
 				return err
 			}
 			nftCollectionModels = append(nftCollectionModels, j...)
+		case state.LastIDXKey:
+			j, err := bs.handleNFTLastIndexState(st)
+			if err != nil {
+				return err
+			}
+			nftLastIndexModels = append(nftLastIndexModels, j...)
 		case state.OperatorsKey:
 			j, err := bs.handleNFTOperatorsState(st)
 			if err != nil {
@@ -42,6 +60,48 @@ func (bs *BlockSession) prepareNFTs() error {
 			}
 			nftModels = append(nftModels, j...)
 			bs.nftMap[st.Key()] = struct{}{}
+
+			j, err = bs.handleNFTHistoryState(st)
+			if err != nil {
+				return err
+			}
+			nftHistoryModels = append(nftHistoryModels, j...)
+		case state.RestrictionModeKey:
+			j, err := bs.handleNFTRestrictionModeState(st)
+			if err != nil {
+				return err
+			}
+			nftRestrictionModeModels = append(nftRestrictionModeModels, j...)
+		case state.RestrictionKey:
+			j, err := bs.handleNFTRestrictionState(st)
+			if err != nil {
+				return err
+			}
+			nftRestrictionModels = append(nftRestrictionModels, j...)
+		case state.CouncilKey:
+			j, err := bs.handleNFTCouncilState(st)
+			if err != nil {
+				return err
+			}
+			nftCouncilModels = append(nftCouncilModels, j...)
+		case state.ProposalKey:
+			j, err := bs.handleNFTProposalState(st)
+			if err != nil {
+				return err
+			}
+			nftProposalModels = append(nftProposalModels, j...)
+		case state.NFTHashKey:
+			j, err := bs.handleNFTHashState(st)
+			if err != nil {
+				return err
+			}
+			nftHashModels = append(nftHashModels, j...)
+		case state.CollectionNameKey:
+			j, err := bs.handleNFTCollectionNameState(st)
+			if err != nil {
+				return err
+			}
+			nftCollectionNameModels = append(nftCollectionNameModels, j...)
 		default:
 			continue
 		}
@@ -49,14 +109,31 @@ func (bs *BlockSession) prepareNFTs() error {
 
 	bs.nftCollectionModels = nftCollectionModels
 	bs.nftOperatorModels = nftOperatorModels
-	bs.nftBoxModels = nftBoxModels
+	bs.nftLastIndexModels = nftLastIndexModels
 	bs.nftModels = nftModels
+	bs.nftHistoryModels = nftHistoryModels
+	bs.nftRestrictionModeModels = nftRestrictionModeModels
+	bs.nftRestrictionModels = nftRestrictionModels
+	bs.nftCouncilModels = nftCouncilModels
+	bs.nftProposalModels = nftProposalModels
+	bs.nftHashModels = nftHashModels
+	bs.nftCollectionNameModels = nftCollectionNameModels
 
 	return nil
 }
 
 func (bs *BlockSession) handleNFTCollectionState(st mitumbase.State) ([]mongo.WriteModel, error) {
-	if nftCollectionDoc, err := NewNFTCollectionDoc(st, bs.st.Encoder()); err != nil {
+	design, err := state.StateCollectionValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredHeight, err := bs.nftCollectionRegisteredHeight(*design)
+	if err != nil {
+		return nil, err
+	}
+
+	if nftCollectionDoc, err := NewNFTCollectionDoc(st, bs.st.Encoder(), registeredHeight); err != nil {
 		return nil, err
 	} else {
 		return []mongo.WriteModel{
@@ -65,6 +142,22 @@ func (bs *BlockSession) handleNFTCollectionState(st mitumbase.State) ([]mongo.Wr
 	}
 }
 
+// nftCollectionRegisteredHeight returns the height the collection of design
+// was registered at; the collection is registered at the current block when it
+// has never been registered or it had been unregistered.
+func (bs *BlockSession) nftCollectionRegisteredHeight(design types.Design) (mitumbase.Height, error) {
+	switch prev, h, err := nftCollectionRegistration(bs.st, design.Contract().String()); {
+	case err == nil:
+		if prev.Active() || !design.Active() {
+			return h, nil
+		}
+	case !errors.Is(err, mitumutil.ErrNotFound):
+		return mitumbase.NilHeight, err
+	}
+
+	return bs.block.Manifest().Height(), nil
+}
+
 func (bs *BlockSession) handleNFTOperatorsState(st mitumbase.State) ([]mongo.WriteModel, error) {
 	if nftCollectionDoc, err := NewNFTOperatorDoc(st, bs.st.Encoder()); err != nil {
 		return nil, err
@@ -85,6 +178,53 @@ func (bs *BlockSession) handleNFTState(st mitumbase.State) ([]mongo.WriteModel,
 	}
 }
 
+// handleNFTHistoryState keeps the owner before st along with st; digest_nft
+// still has the previous version of nft, because it is cleaned at commit.
+func (bs *BlockSession) handleNFTHistoryState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	parsedKey, err := crcystate.ParseStateKey(st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	var prevOwner string
+	switch prev, err := NFT(bs.st, parsedKey[1], parsedKey[2], mitumbase.NilHeight); {
+	case err == nil:
+		prevOwner = prev.Owner().String()
+	case !errors.Is(err, mitumutil.ErrNotFound):
+		return nil, err
+	}
+
+	if nftHistoryDoc, err := NewNFTHistoryDoc(st, bs.st.Encoder(), prevOwner, bs.isForced(st)); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftHistoryDoc),
+		}, nil
+	}
+}
+
+// isForced reports whether st is written by force transfer, so the clawback of
+// nft can be told from the transfer by its owner in the history.
+func (bs *BlockSession) isForced(st mitumbase.State) bool {
+	if bs.forcedFacts == nil {
+		bs.forcedFacts = map[string]struct{}{}
+
+		for i := range bs.ops {
+			if _, ok := bs.ops[i].(nft.ForceTransfer); ok {
+				bs.forcedFacts[bs.ops[i].Fact().Hash().String()] = struct{}{}
+			}
+		}
+	}
+
+	for i := range st.Operations() {
+		if _, found := bs.forcedFacts[st.Operations()[i].String()]; found {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (bs *BlockSession) handleNFTLastIndexState(st mitumbase.State) ([]mongo.WriteModel, error) {
 	if nftLastIndexDoc, err := NewNFTLastIndexDoc(st, bs.st.Encoder()); err != nil {
 		return nil, err
@@ -94,3 +234,63 @@ func (bs *BlockSession) handleNFTLastIndexState(st mitumbase.State) ([]mongo.Wri
 		}, nil
 	}
 }
+
+func (bs *BlockSession) handleNFTRestrictionModeState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if nftRestrictionModeDoc, err := NewNFTRestrictionModeDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftRestrictionModeDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleNFTRestrictionState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if nftRestrictionDoc, err := NewNFTRestrictionDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftRestrictionDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleNFTCouncilState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if nftCouncilDoc, err := NewNFTCouncilDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftCouncilDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleNFTProposalState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if nftProposalDoc, err := NewNFTProposalDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftProposalDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleNFTHashState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if nftHashDoc, err := NewNFTHashDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftHashDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleNFTCollectionNameState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if nftCollectionNameDoc, err := NewNFTCollectionNameDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftCollectionNameDoc),
+		}, nil
+	}
+}
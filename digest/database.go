@@ -8,9 +8,12 @@ import (
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"go.mongodb.org/mongo-driver/bson"
 	"strconv"
+	"time"
 
 	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
+	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -18,27 +21,60 @@ import (
 var maxLimit int64 = 50
 
 var (
-	defaultColNameAccount         = "digest_ac"
-	defaultColNameContractAccount = "digest_ca"
-	defaultColNameBalance         = "digest_bl"
-	defaultColNameCurrency        = "digest_cr"
-	defaultColNameOperation       = "digest_op"
-	defaultColNameBlock           = "digest_bm"
-	defaultColNameNFTCollection   = "digest_nftcollection"
-	defaultColNameNFT             = "digest_nft"
-	defaultColNameNFTOperator     = "digest_nftoperator"
+	defaultColNameAccount            = "digest_ac"
+	defaultColNameContractAccount    = "digest_ca"
+	defaultColNameBalance            = "digest_bl"
+	defaultColNameCurrency           = "digest_cr"
+	defaultColNameOperation          = "digest_op"
+	defaultColNameBlock              = "digest_bm"
+	defaultColNameNFTCollection      = "digest_nftcollection"
+	defaultColNameNFT                = "digest_nft"
+	defaultColNameNFTHistory         = "digest_nfthistory"
+	defaultColNameNFTLastIndex       = "digest_nftlastindex"
+	defaultColNameNFTOperator        = "digest_nftoperator"
+	defaultColNameNFTRestrictionMode = "digest_nftrestrictionmode"
+	defaultColNameNFTRestriction     = "digest_nftrestriction"
+	defaultColNameNFTCouncil         = "digest_nftcouncil"
+	defaultColNameNFTProposal        = "digest_nftproposal"
+	defaultColNameNFTHash            = "digest_nfthash"
+	defaultColNameNFTCollectionName  = "digest_nftcollectionname"
 )
 
-func NFTCollection(st *currencydigest.Database, contract string) (*types.Design, error) {
-	filter := util.NewBSONFilter("contract", contract)
+// NFTCollection returns the collection design in contract as of height; with
+// mitumbase.NilHeight, the latest one.
+func NFTCollection(st *currencydigest.Database, contract string, height mitumbase.Height) (*types.Design, error) {
+	design, _, err := nftCollectionAt(st, contract, height)
+
+	return design, err
+}
+
+// nftCollectionRegistration returns the latest collection design in contract
+// and the height the collection was registered at.
+func nftCollectionRegistration(st *currencydigest.Database, contract string) (*types.Design, mitumbase.Height, error) {
+	return nftCollectionAt(st, contract, mitumbase.NilHeight)
+}
+
+func nftCollectionAt(
+	st *currencydigest.Database, contract string, height mitumbase.Height,
+) (*types.Design, mitumbase.Height, error) {
+	filter := filterAtHeight(util.NewBSONFilter("contract", contract), height)
 
 	var design *types.Design
+	var registeredHeight mitumbase.Height
 	var sta mitumbase.State
 	var err error
 	if err := st.MongoClient().GetByFilter(
 		defaultColNameNFTCollection,
 		filter.D(),
 		func(res *mongo.SingleResult) error {
+			var u struct {
+				RegisteredHeight int64 `bson:"registered_height"`
+			}
+			if err := res.Decode(&u); err != nil {
+				return err
+			}
+			registeredHeight = mitumbase.Height(u.RegisteredHeight)
+
 			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
 			if err != nil {
 				return err
@@ -53,13 +89,112 @@ func NFTCollection(st *currencydigest.Database, contract string) (*types.Design,
 		},
 		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
 	); err != nil {
-		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft collection for contract account %v", contract)
+		return nil, mitumbase.NilHeight, mitumutil.ErrNotFound.WithMessage(
+			err, "nft collection for contract account %v", contract)
+	}
+
+	return design, registeredHeight, nil
+}
+
+// filterRegisteredAtHeight narrows filter of the documents kept per registration
+// of the collection in contract, like council and restriction, to the ones as of
+// height written since the collection was registered. The documents of the
+// previous registration are not cleaned when the collection is unregistered, so
+// the queries at the heights before can still read them.
+func filterRegisteredAtHeight(
+	st *currencydigest.Database, filter *util.BSONFilter, contract string, height mitumbase.Height,
+) (*util.BSONFilter, error) {
+	var cond bson.D
+	switch _, registeredHeight, err := nftCollectionAt(st, contract, height); {
+	case err == nil:
+		cond = append(cond, bson.E{Key: "$gte", Value: registeredHeight})
+	case !errors.Is(err, mitumutil.ErrNotFound):
+		return nil, err
+	}
+
+	if height > mitumbase.NilHeight {
+		cond = append(cond, bson.E{Key: "$lte", Value: height})
+	}
+
+	if len(cond) < 1 {
+		return filter, nil
+	}
+
+	return filter.Add("height", cond), nil
+}
+
+type RegisteredCollection struct {
+	Design           types.Design     `json:"design"`
+	RegisteredHeight mitumbase.Height `json:"registered_height"`
+}
+
+func NFTCollections(
+	st *currencydigest.Database,
+	filter CollectionsFilter,
+	offset string,
+	reverse bool,
+	limit int64,
+	callback func(collection RegisteredCollection, st mitumbase.State) (bool, error),
+) error {
+	match, err := buildCollectionsFilter(filter, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	// every version of collection design is kept, so the latest one is picked.
+	pipeline := mongo.Pipeline{
+		bson.D{{"$sort", bson.D{{"height", -1}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$contract"},
+			{"doc", bson.D{{"$first", "$$ROOT"}}},
+		}}},
+		bson.D{{"$replaceRoot", bson.D{{"newRoot", "$doc"}}}},
+		bson.D{{"$match", match}},
+		bson.D{{"$sort", util.NewBSONFilter("registered_height", sr).Add("contract", sr).D()}},
+		bson.D{{"$limit", limit}},
 	}
 
-	return design, nil
+	return aggregate(
+		context.Background(), st, defaultColNameNFTCollection, pipeline,
+		func(cursor *mongo.Cursor) (bool, error) {
+			var u struct {
+				RegisteredHeight int64 `bson:"registered_height"`
+			}
+			if err := cursor.Decode(&u); err != nil {
+				return false, err
+			}
+
+			sta, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			design, err := state.StateCollectionValue(sta)
+			if err != nil {
+				return false, err
+			}
+
+			return callback(RegisteredCollection{
+				Design:           *design,
+				RegisteredHeight: mitumbase.Height(u.RegisteredHeight),
+			}, sta)
+		},
+	)
 }
 
-func NFT(st *currencydigest.Database, contract, idx string) (*types.NFT, error) {
+// NFT returns the nft as of height; with mitumbase.NilHeight, the latest one.
+// digest_nft keeps only the latest versions, so the previous versions are
+// looked up in nft history.
+func NFT(st *currencydigest.Database, contract, idx string, height mitumbase.Height) (*types.NFT, error) {
 	i, err := strconv.ParseUint(idx, 10, 64)
 	if err != nil {
 		return nil, err
@@ -68,10 +203,16 @@ func NFT(st *currencydigest.Database, contract, idx string) (*types.NFT, error)
 	filter := util.NewBSONFilter("contract", contract)
 	filter = filter.Add("nft_idx", i)
 
+	col := defaultColNameNFT
+	if height > mitumbase.NilHeight {
+		col = defaultColNameNFTHistory
+		filter = filterAtHeight(filter, height)
+	}
+
 	var nft *types.NFT
 	var sta mitumbase.State
 	if err = st.MongoClient().GetByFilter(
-		defaultColNameNFT,
+		col,
 		filter.D(),
 		func(res *mongo.SingleResult) error {
 			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
@@ -98,9 +239,11 @@ func NFTsByCollection(
 	contract, factHash, offset string,
 	reverse bool,
 	limit int64,
+	traits []TraitFilter,
+	height mitumbase.Height,
 	callback func(nft types.NFT, st mitumbase.State) (bool, error),
 ) error {
-	filter, err := buildNFTsFilterByContract(contract, factHash, offset, reverse)
+	filter, err := buildNFTsFilterByContract(contract, factHash, offset, reverse, traits)
 	if err != nil {
 		return err
 	}
@@ -122,6 +265,117 @@ func NFTsByCollection(
 		opt = opt.SetLimit(limit)
 	}
 
+	f := func(cursor *mongo.Cursor) (bool, error) {
+		st, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+		if err != nil {
+			return false, err
+		}
+		nft, err := state.StateNFTValue(st)
+		if err != nil {
+			return false, err
+		}
+		return callback(*nft, st)
+	}
+
+	if height <= mitumbase.NilHeight {
+		return st.MongoClient().Find(context.Background(), defaultColNameNFT, filter, f, opt)
+	}
+
+	// the versions of nfts at height are picked from nft history
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", filterAtHeight(util.NewBSONFilter("contract", contract), height).D()}},
+		bson.D{{"$sort", bson.D{{"nft_idx", 1}, {"height", -1}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$nft_idx"},
+			{"doc", bson.D{{"$first", "$$ROOT"}}},
+		}}},
+		bson.D{{"$replaceRoot", bson.D{{"newRoot", "$doc"}}}},
+		bson.D{{"$match", filter}},
+		bson.D{{"$sort", util.NewBSONFilter("nft_idx", sr).D()}},
+	}
+	if opt.Limit != nil {
+		pipeline = append(pipeline, bson.D{{"$limit", *opt.Limit}})
+	}
+
+	return aggregate(context.Background(), st, defaultColNameNFTHistory, pipeline, f)
+}
+
+// NFTsByOwner finds the nfts owned by owner across collections; contract
+// narrows them to one collection when it is not empty.
+func NFTsByOwner(
+	st *currencydigest.Database,
+	owner, contract, offset string,
+	reverse bool,
+	limit int64,
+	callback func(contract string, nft types.NFT, st mitumbase.State) (bool, error),
+) error {
+	filter, err := buildNFTsFilterByOwner(owner, contract, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	return nftsByAccount(st, filter, reverse, limit, callback)
+}
+
+// NFTsByApproved finds the nfts which approved is approved for across
+// collections; contract narrows them to one collection when it is not empty.
+func NFTsByApproved(
+	st *currencydigest.Database,
+	approved, contract, offset string,
+	reverse bool,
+	limit int64,
+	callback func(contract string, nft types.NFT, st mitumbase.State) (bool, error),
+) error {
+	filter, err := buildNFTsFilterByApproved(approved, contract, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	return nftsByAccount(st, filter, reverse, limit, callback)
+}
+
+// NFTsByCreator finds the nfts which creator is one of creators across
+// collections; with pending, only the ones waiting for the sign of creator.
+// contract narrows them to one collection when it is not empty.
+func NFTsByCreator(
+	st *currencydigest.Database,
+	creator, contract, offset string,
+	pending, reverse bool,
+	limit int64,
+	callback func(contract string, nft types.NFT, st mitumbase.State) (bool, error),
+) error {
+	filter, err := buildNFTsFilterByCreator(creator, contract, offset, pending, reverse)
+	if err != nil {
+		return err
+	}
+
+	return nftsByAccount(st, filter, reverse, limit, callback)
+}
+
+func nftsByAccount(
+	st *currencydigest.Database,
+	filter bson.D,
+	reverse bool,
+	limit int64,
+	callback func(contract string, nft types.NFT, st mitumbase.State) (bool, error),
+) error {
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("contract", sr).Add("nft_idx", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
 	return st.MongoClient().Find(
 		context.Background(),
 		defaultColNameNFT,
@@ -131,75 +385,1137 @@ func NFTsByCollection(
 			if err != nil {
 				return false, err
 			}
+
+			parsedKey, err := crcystate.ParseStateKey(st.Key(), state.NFTPrefix, 4)
+			if err != nil {
+				return false, err
+			}
+
 			nft, err := state.StateNFTValue(st)
 			if err != nil {
 				return false, err
 			}
-			return callback(*nft, st)
+			return callback(parsedKey[1], *nft, st)
 		},
 		opt,
 	)
 }
 
-func NFTCountByCollection(
+// NFTHistory is a version of nft in its provenance timeline.
+type NFTHistory struct {
+	Height     mitumbase.Height `json:"height"`
+	FactHashes []string         `json:"facthash"`
+	PrevOwner  string           `json:"prev_owner,omitempty"`
+	Forced     bool             `json:"forced,omitempty"`
+	Backfilled bool             `json:"backfilled,omitempty"`
+	NFT        types.NFT        `json:"nft"`
+}
+
+// NFTHistoryCompleteHeight returns the height from which nft history keeps
+// every version of the nfts in contract; mitumbase.NilHeight when it is kept
+// from the first mint. The nft whose first version in the history is not its
+// mint, like the backfilled one, was digested before the history is kept, so
+// its versions before that are not known.
+func NFTHistoryCompleteHeight(st *currencydigest.Database, contract string) (mitumbase.Height, error) {
+	var complete struct {
+		Height int64 `bson:"height"`
+	}
+
+	switch err := aggregateOne(context.Background(), st, defaultColNameNFTHistory, mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"contract", contract}}}},
+		bson.D{{"$sort", bson.D{{"nft_idx", 1}, {"height", 1}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$nft_idx"},
+			{"height", bson.D{{"$first", "$height"}}},
+			{"prev_owner", bson.D{{"$first", "$prev_owner"}}},
+			{"backfilled", bson.D{{"$first", "$backfilled"}}},
+		}}},
+		bson.D{{"$match", bson.D{{"$or", bson.A{
+			bson.D{{"prev_owner", bson.D{{"$nin", bson.A{"", nil}}}}},
+			bson.D{{"backfilled", true}},
+		}}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", nil},
+			{"height", bson.D{{"$max", "$height"}}},
+		}}},
+	}, &complete); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return mitumbase.NilHeight, nil
+	case err != nil:
+		return mitumbase.NilHeight, err
+	}
+
+	return mitumbase.Height(complete.Height), nil
+}
+
+// BackfillNFTHistory writes the versions in digest_nft of the nfts which have
+// no version in nft history, like the nfts digested before the history is
+// kept, so the nfts untouched since then are found as of heights after their
+// versions. It returns the number of backfilled nfts.
+func BackfillNFTHistory(ctx context.Context, st *currencydigest.Database) (int, error) {
+	var models []mongo.WriteModel
+	var n int
+
+	write := func() error {
+		if len(models) < 1 {
+			return nil
+		}
+
+		if _, err := st.MongoClient().Collection(defaultColNameNFTHistory).BulkWrite(
+			ctx, models, options.BulkWrite().SetOrdered(false),
+		); err != nil {
+			return err
+		}
+
+		n += len(models)
+		models = nil
+
+		return nil
+	}
+
+	if err := aggregate(ctx, st, defaultColNameNFT, mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"istoken", true}}}},
+		bson.D{{"$lookup", bson.D{
+			{"from", defaultColNameNFTHistory},
+			{"let", bson.D{{"contract", "$contract"}, {"nft_idx", "$nft_idx"}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$and", bson.A{
+					bson.D{{"$eq", bson.A{"$contract", "$$contract"}}},
+					bson.D{{"$eq", bson.A{"$nft_idx", "$$nft_idx"}}},
+				}}}}}}},
+				bson.D{{"$limit", 1}},
+				bson.D{{"$project", bson.D{{"_id", 1}}}},
+			}},
+			{"as", "history"},
+		}}},
+		bson.D{{"$match", bson.D{{"history", bson.D{{"$size", 0}}}}}},
+	}, func(cursor *mongo.Cursor) (bool, error) {
+		sta, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+		if err != nil {
+			return false, err
+		}
+
+		// NOTE the owner before the backfilled version is not known.
+		doc, err := NewNFTHistoryDoc(sta, st.Encoder(), "", false)
+		if err != nil {
+			return false, err
+		}
+		doc.backfilled = true
+
+		models = append(models, mongo.NewInsertOneModel().SetDocument(doc))
+		if len(models) < bulkWriteLimit {
+			return true, nil
+		}
+
+		return true, write()
+	}, options.Aggregate().SetAllowDiskUse(true)); err != nil {
+		return n, err
+	}
+
+	return n, write()
+}
+
+// NFTHistories finds the versions of nft of idx in contract ordered by height;
+// offset is the height of the last version of previous page.
+func NFTHistories(
 	st *currencydigest.Database,
-	contract string,
-) (int64, error) {
-	filterA := bson.A{}
+	contract, idx, offset string,
+	reverse bool,
+	limit int64,
+	callback func(history NFTHistory) (bool, error),
+) error {
+	i, err := strconv.ParseUint(idx, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	filterA := bson.A{
+		bson.D{{"contract", contract}},
+		bson.D{{"nft_idx", i}},
+	}
 
-	// filter fot matching collection
-	filterContract := bson.D{{"contract", bson.D{{"$in", []string{contract}}}}}
-	filterToken := bson.D{{"istoken", true}}
-	filterA = append(filterA, filterToken)
-	filterA = append(filterA, filterContract)
+	if len(offset) > 0 {
+		h, err := strconv.ParseInt(offset, 10, 64)
+		if err != nil {
+			return err
+		}
 
-	filter := bson.D{}
-	if len(filterA) > 0 {
-		filter = bson.D{
-			{"$and", filterA},
+		op := "$gt"
+		if reverse {
+			op = "$lt"
 		}
+		filterA = append(filterA, bson.D{{"height", bson.D{{op, h}}}})
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
 	}
 
-	opt := options.Count()
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("height", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
 
-	return st.MongoClient().Count(
+	return st.MongoClient().Find(
 		context.Background(),
-		defaultColNameNFT,
-		filter,
+		defaultColNameNFTHistory,
+		bson.D{{"$and", filterA}},
+		func(cursor *mongo.Cursor) (bool, error) {
+			var u struct {
+				PrevOwner  string `bson:"prev_owner"`
+				Forced     bool   `bson:"forced"`
+				Backfilled bool   `bson:"backfilled"`
+			}
+			if err := cursor.Decode(&u); err != nil {
+				return false, err
+			}
+
+			st, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+			nft, err := state.StateNFTValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			hashes := make([]string, len(st.Operations()))
+			for i := range st.Operations() {
+				hashes[i] = st.Operations()[i].String()
+			}
+
+			return callback(NFTHistory{
+				Height:     st.Height(),
+				FactHashes: hashes,
+				PrevOwner:  u.PrevOwner,
+				Forced:     u.Forced,
+				Backfilled: u.Backfilled,
+				NFT:        *nft,
+			})
+		},
 		opt,
 	)
 }
 
-func NFTOperators(
+type NFTSupply struct {
+	Height mitumbase.Height
+	Minted uint64
+	Burned uint64
+}
+
+// Active returns the number of nfts which are not burned.
+func (s NFTSupply) Active() uint64 {
+	return s.Minted - s.Burned
+}
+
+// NFTSupplyByCollection counts the nfts of collection in contract as of
+// height; with mitumbase.NilHeight, as of the latest height. Minted comes from
+// the last nft index. Burned comes from the latest versions of nft in
+// digest_nft as of the latest height, and from the versions of nft history as
+// of the previous height; the height before nft history of contract is
+// complete is refused, because the burns before it are not known.
+//
+// The last nft index of the collection which has not been minted since
+// digest_nftlastindex is kept is not found; for the latest height, the supply
+// is counted from the latest versions of nft in digest_nft instead.
+func NFTSupplyByCollection(
 	st *currencydigest.Database,
-	contract, account string,
-) (*types.AllApprovedBook, error) {
-	filter := util.NewBSONFilter("contract", contract)
-	filter = filter.Add("address", account)
+	contract string,
+	height mitumbase.Height,
+) (*NFTSupply, error) {
+	ctx := context.Background()
 
-	var operators *types.AllApprovedBook
+	filterA := bson.A{bson.D{{"contract", contract}}}
+	if height > mitumbase.NilHeight {
+		filterA = append(filterA, bson.D{{"height", bson.D{{"$lte", height}}}})
+	}
+	filter := bson.D{{"$and", filterA}}
+
+	var last uint64
 	var sta mitumbase.State
 	var err error
-	if err := st.MongoClient().GetByFilter(
-		defaultColNameNFTOperator,
-		filter.D(),
+	switch err := st.MongoClient().GetByFilter(
+		defaultColNameNFTLastIndex,
+		filter,
 		func(res *mongo.SingleResult) error {
 			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
 			if err != nil {
 				return err
 			}
 
-			operators, err = state.StateOperatorsBookValue(sta)
-			if err != nil {
-				return err
-			}
+			last, err = state.StateLastNFTIndexValue(sta)
 
-			return nil
+			return err
 		},
 		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
-	); err != nil {
-		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft operators by contract %s and account %s", contract, account)
+	); {
+	case err == nil:
+	case height <= mitumbase.NilHeight:
+		return nftSupplyByNFTs(ctx, st, contract)
+	default:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft last index for contract account %v as of height %v", contract, height)
 	}
 
-	return operators, nil
+	supply := &NFTSupply{
+		Height: height,
+		Minted: last,
+	}
+
+	if height <= mitumbase.NilHeight {
+		supply.Height = sta.Height()
+
+		switch counted, err := nftSupplyByNFTs(ctx, st, contract); {
+		case errors.Is(err, mitumutil.ErrNotFound):
+		case err != nil:
+			return nil, err
+		default:
+			supply.Burned = counted.Burned
+
+			if counted.Height > supply.Height {
+				supply.Height = counted.Height
+			}
+		}
+
+		return supply, nil
+	}
+
+	switch complete, err := NFTHistoryCompleteHeight(st, contract); {
+	case err != nil:
+		return nil, err
+	case height < complete:
+		return nil, errors.Errorf(
+			"nft supply of contract account %v as of height %v; nft history is complete from height %v",
+			contract, height, complete)
+	}
+
+	var burned struct {
+		N int64 `bson:"n"`
+	}
+
+	switch err := aggregateOne(ctx, st, defaultColNameNFTHistory, mongo.Pipeline{
+		bson.D{{"$match", filter}},
+		bson.D{{"$sort", bson.D{{"nft_idx", 1}, {"height", -1}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$nft_idx"},
+			{"active", bson.D{{"$first", "$active"}}},
+		}}},
+		bson.D{{"$group", bson.D{
+			{"_id", nil},
+			{"n", bson.D{{"$sum", bson.D{{"$cond", bson.A{bson.D{{"$eq", bson.A{"$active", false}}}, 1, 0}}}}}},
+		}}},
+	}, &burned); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+	case err != nil:
+		return nil, err
+	default:
+		supply.Burned = uint64(burned.N)
+	}
+
+	return supply, nil
+}
+
+// nftSupplyByNFTs counts the latest versions of nft in contract; the burned
+// ones are counted for the latest supply, and the minted ones only for the
+// collection whose last nft index is not kept in digest_nftlastindex.
+func nftSupplyByNFTs(ctx context.Context, st *currencydigest.Database, contract string) (*NFTSupply, error) {
+	var counted struct {
+		Minted int64 `bson:"minted"`
+		Burned int64 `bson:"burned"`
+		Height int64 `bson:"height"`
+	}
+
+	switch err := aggregateOne(ctx, st, defaultColNameNFT, mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"contract", contract}, {"istoken", true}}}},
+		bson.D{{"$sort", bson.D{{"nft_idx", 1}, {"height", -1}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$nft_idx"},
+			{"active", bson.D{{"$first", "$active"}}},
+			{"height", bson.D{{"$first", "$height"}}},
+		}}},
+		bson.D{{"$group", bson.D{
+			{"_id", nil},
+			{"minted", bson.D{{"$sum", 1}}},
+			{"burned", bson.D{{"$sum", bson.D{{"$cond", bson.A{bson.D{{"$eq", bson.A{"$active", false}}}, 1, 0}}}}}},
+			{"height", bson.D{{"$max", "$height"}}},
+		}}},
+	}, &counted); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, mitumutil.ErrNotFound.Errorf("nfts for contract account %v", contract)
+	case err != nil:
+		return nil, err
+	}
+
+	return &NFTSupply{
+		Height: mitumbase.Height(counted.Height),
+		Minted: uint64(counted.Minted),
+		Burned: uint64(counted.Burned),
+	}, nil
+}
+
+type NFTHolding struct {
+	Address string   `json:"address" bson:"_id"`
+	Count   int64    `json:"count" bson:"count"`
+	NFTs    []uint64 `json:"nft_idxes" bson:"nft_idxes"`
+}
+
+// NFTHolderSnapshot calls callback with the holdings of every owner of active
+// nfts in contract as of height, ordered by address; with mitumbase.NilHeight,
+// as of the latest height. The holdings are not limited by maxLimit, so
+// callback should not buffer them. The height before nft history of contract
+// is complete is refused, because the snapshot would miss the holders of the
+// nfts not known as of that height.
+func NFTHolderSnapshot(
+	st *currencydigest.Database,
+	contract string,
+	height mitumbase.Height,
+	callback func(holding NFTHolding) (bool, error),
+) error {
+	if height > mitumbase.NilHeight {
+		switch complete, err := NFTHistoryCompleteHeight(st, contract); {
+		case err != nil:
+			return err
+		case height < complete:
+			return errors.Errorf(
+				"holder snapshot of contract account %v as of height %v; nft history is complete from height %v",
+				contract, height, complete)
+		}
+	}
+
+	byOwner := mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"active", bson.D{{"$ne", false}}}}}},
+		bson.D{{"$sort", bson.D{{"nft_idx", 1}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$owner"},
+			{"count", bson.D{{"$sum", 1}}},
+			{"nft_idxes", bson.D{{"$push", "$nft_idx"}}},
+		}}},
+		bson.D{{"$sort", bson.D{{"_id", 1}}}},
+	}
+
+	col := defaultColNameNFT
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"contract", contract}, {"istoken", true}}}},
+	}
+
+	if height > mitumbase.NilHeight {
+		col = defaultColNameNFTHistory
+		pipeline = mongo.Pipeline{
+			bson.D{{"$match", filterAtHeight(util.NewBSONFilter("contract", contract), height).D()}},
+			bson.D{{"$sort", bson.D{{"nft_idx", 1}, {"height", -1}}}},
+			bson.D{{"$group", bson.D{
+				{"_id", "$nft_idx"},
+				{"doc", bson.D{{"$first", "$$ROOT"}}},
+			}}},
+			bson.D{{"$replaceRoot", bson.D{{"newRoot", "$doc"}}}},
+		}
+	}
+
+	return aggregate(
+		context.Background(), st, col, append(pipeline, byOwner...),
+		func(cursor *mongo.Cursor) (bool, error) {
+			var holding NFTHolding
+			if err := cursor.Decode(&holding); err != nil {
+				return false, err
+			}
+
+			return callback(holding)
+		},
+		options.Aggregate().SetAllowDiskUse(true),
+	)
+}
+
+type NFTOperation struct {
+	FactHash    string              `json:"facthash"`
+	Type        string              `json:"type"`
+	Sender      string              `json:"sender,omitempty"`
+	Height      mitumbase.Height    `json:"height"`
+	ConfirmedAt time.Time           `json:"confirmed_at"`
+	InState     bool                `json:"in_state"`
+	Operation   mitumbase.Operation `json:"operation"`
+}
+
+// NFTOperations returns the operations which touched nft; the fact hashes kept
+// by nft history are joined with the operation documents. offset and limit are
+// applied to the versions of nft, so one page may have more operations than
+// limit when an nft is touched by several operations in one block.
+func NFTOperations(
+	st *currencydigest.Database,
+	contract, idx, offset string,
+	reverse bool,
+	limit int64,
+	callback func(op NFTOperation) (bool, error),
+) error {
+	var hashes []string
+	if err := NFTHistories(st, contract, idx, offset, reverse, limit, func(history NFTHistory) (bool, error) {
+		hashes = append(hashes, history.FactHashes...)
+
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	if len(hashes) < 1 {
+		return nil
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	return st.MongoClient().Find(
+		context.Background(),
+		defaultColNameOperation,
+		bson.D{{"fact", bson.D{{"$in", hashes}}}},
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := currencydigest.LoadOperation(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			op := va.Operation()
+
+			var sender string
+			if i, ok := op.Fact().(interface{ Sender() mitumbase.Address }); ok && i.Sender() != nil {
+				sender = i.Sender().String()
+			}
+
+			return callback(NFTOperation{
+				FactHash:    op.Fact().Hash().String(),
+				Type:        op.Hint().Type().String(),
+				Sender:      sender,
+				Height:      va.Height(),
+				ConfirmedAt: va.ConfirmedAt(),
+				InState:     va.InState(),
+				Operation:   op,
+			})
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", sr).Add("index", sr).D()),
+	)
+}
+
+type NFTHolder struct {
+	Address string `json:"address" bson:"_id"`
+	Count   int64  `json:"count" bson:"count"`
+}
+
+type NFTTransferPeriod struct {
+	FromHeight int64 `json:"from_height" bson:"_id"`
+	Transfers  int64 `json:"transfers" bson:"transfers"`
+}
+
+type CollectionStats struct {
+	Contract           string              `json:"contract"`
+	ActiveSupply       int64               `json:"active_supply"`
+	Burned             int64               `json:"burned"`
+	Holders            int64               `json:"holders"`
+	TopHolders         []NFTHolder         `json:"top_holders"`
+	Period             int64               `json:"period"`
+	Transfers          []NFTTransferPeriod `json:"transfers"`
+	LastActivityHeight mitumbase.Height    `json:"last_activity_height"`
+}
+
+// NFTCollectionStats aggregates the nfts of collection in contract; transfers
+// are counted by the owner changes of nfts in nft history and grouped by period
+// of heights, from the latest periods.
+func NFTCollectionStats(
+	st *currencydigest.Database,
+	contract string,
+	top, period, periods int64,
+) (*CollectionStats, error) {
+	ctx := context.Background()
+
+	active := bson.D{{"$match", bson.D{{"active", bson.D{{"$ne", false}}}}}}
+	byOwner := bson.D{{"$group", bson.D{{"_id", "$owner"}, {"count", bson.D{{"$sum", 1}}}}}}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"contract", contract}, {"istoken", true}}}},
+		bson.D{{"$facet", bson.D{
+			{"supply", bson.A{active, bson.D{{"$count", "n"}}}},
+			{"burned", bson.A{bson.D{{"$match", bson.D{{"active", false}}}}, bson.D{{"$count", "n"}}}},
+			{"holders", bson.A{active, byOwner, bson.D{{"$count", "n"}}}},
+			{"top_holders", bson.A{
+				active, byOwner,
+				bson.D{{"$sort", bson.D{{"count", -1}, {"_id", 1}}}},
+				bson.D{{"$limit", top}},
+			}},
+		}}},
+	}
+
+	var facet struct {
+		Supply []struct {
+			N int64 `bson:"n"`
+		} `bson:"supply"`
+		Burned []struct {
+			N int64 `bson:"n"`
+		} `bson:"burned"`
+		Holders []struct {
+			N int64 `bson:"n"`
+		} `bson:"holders"`
+		TopHolders []NFTHolder `bson:"top_holders"`
+	}
+
+	if err := aggregateOne(ctx, st, defaultColNameNFT, pipeline, &facet); err != nil {
+		return nil, err
+	}
+
+	stats := &CollectionStats{
+		Contract:   contract,
+		TopHolders: facet.TopHolders,
+		Period:     period,
+	}
+	if len(facet.Supply) > 0 {
+		stats.ActiveSupply = facet.Supply[0].N
+	}
+	if len(facet.Burned) > 0 {
+		stats.Burned = facet.Burned[0].N
+	}
+	if len(facet.Holders) > 0 {
+		stats.Holders = facet.Holders[0].N
+	}
+
+	transfers, err := nftCollectionTransfers(ctx, st, contract, period, periods)
+	if err != nil {
+		return nil, err
+	}
+	stats.Transfers = transfers
+
+	for _, col := range []string{defaultColNameNFTHistory, defaultColNameNFTCollection} {
+		var last struct {
+			Height int64 `bson:"height"`
+		}
+
+		switch err := st.MongoClient().Collection(col).FindOne(
+			ctx,
+			util.NewBSONFilter("contract", contract).D(),
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()).
+				SetProjection(bson.D{{"height", 1}}),
+		).Decode(&last); {
+		case errors.Is(err, mongo.ErrNoDocuments):
+		case err != nil:
+			return nil, err
+		case mitumbase.Height(last.Height) > stats.LastActivityHeight:
+			stats.LastActivityHeight = mitumbase.Height(last.Height)
+		}
+	}
+
+	if stats.LastActivityHeight < 1 && stats.ActiveSupply < 1 && stats.Burned < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("nft collection stats for contract account %v", contract)
+	}
+
+	return stats, nil
+}
+
+// nftCollectionTransfers counts the versions of nft history in contract whose
+// owner is changed from the previous owner, grouped by period of heights from
+// the latest periods; the mints and the backfilled versions have no previous
+// owner, so they are not counted.
+func nftCollectionTransfers(
+	ctx context.Context,
+	st *currencydigest.Database,
+	contract string,
+	period, periods int64,
+) ([]NFTTransferPeriod, error) {
+	last := st.LastBlock().Int64()
+	if last < 0 {
+		return nil, nil
+	}
+
+	from := last - last%period - (periods-1)*period
+
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{
+			{"contract", contract},
+			{"height", bson.D{{"$gte", from}}},
+			{"prev_owner", bson.D{{"$nin", bson.A{"", nil}}}},
+			{"$expr", bson.D{{"$ne", bson.A{"$prev_owner", "$owner"}}}},
+		}}},
+		bson.D{{"$group", bson.D{
+			{"_id", bson.D{{"$subtract", bson.A{"$height", bson.D{{"$mod", bson.A{"$height", period}}}}}}},
+			{"transfers", bson.D{{"$sum", 1}}},
+		}}},
+		bson.D{{"$sort", bson.D{{"_id", -1}}}},
+		bson.D{{"$limit", periods}},
+	}
+
+	var transfers []NFTTransferPeriod
+
+	if err := aggregate(ctx, st, defaultColNameNFTHistory, pipeline, func(cursor *mongo.Cursor) (bool, error) {
+		var t NFTTransferPeriod
+		if err := cursor.Decode(&t); err != nil {
+			return false, err
+		}
+
+		transfers = append(transfers, t)
+
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// aggregate runs pipeline over col and calls callback with each result until
+// callback returns false.
+func aggregate(
+	ctx context.Context,
+	st *currencydigest.Database,
+	col string,
+	pipeline mongo.Pipeline,
+	callback func(*mongo.Cursor) (bool, error),
+	opts ...*options.AggregateOptions,
+) error {
+	cursor, err := st.MongoClient().Collection(col).Aggregate(ctx, pipeline, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	for cursor.Next(ctx) {
+		switch keep, err := callback(cursor); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return cursor.Err()
+}
+
+func aggregateOne(ctx context.Context, st *currencydigest.Database, col string, pipeline mongo.Pipeline, v interface{}) error {
+	cursor, err := st.MongoClient().Collection(col).Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	if !cursor.Next(ctx) {
+		if err := cursor.Err(); err != nil {
+			return err
+		}
+
+		return mongo.ErrNoDocuments
+	}
+
+	return cursor.Decode(v)
+}
+
+func NFTOperators(
+	st *currencydigest.Database,
+	contract, account string,
+	height mitumbase.Height,
+) (*types.AllApprovedBook, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("address", account)
+	filter, err := filterRegisteredAtHeight(st, filter, contract, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var operators *types.AllApprovedBook
+	var sta mitumbase.State
+	if err := st.MongoClient().GetByFilter(
+		defaultColNameNFTOperator,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			operators, err = state.StateOperatorsBookValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft operators by contract %s and account %s", contract, account)
+	}
+
+	return operators, nil
+}
+
+type NFTAllApprover struct {
+	Contract    string                `json:"contract"`
+	Owner       string                `json:"owner"`
+	AllApproved types.AllApprovedBook `json:"allapproved"`
+}
+
+// NFTAllApprovers finds the owners who approved operator for all their nfts
+// across collections; contract narrows them to one collection when it is not
+// empty. Every version of approved book is kept, so the matched books are
+// checked to be the latest one of the owner and to be written since the
+// collection was registered.
+func NFTAllApprovers(
+	st *currencydigest.Database,
+	operator, contract, offset string,
+	reverse bool,
+	limit int64,
+	callback func(approver NFTAllApprover) (bool, error),
+) error {
+	filterA := bson.A{bson.D{{"operators", operator}}}
+	if len(contract) > 0 {
+		filterA = append(filterA, bson.D{{"contract", contract}})
+	}
+
+	op := "$gt"
+	sr := 1
+	if reverse {
+		op = "$lt"
+		sr = -1
+	}
+
+	if len(offset) > 0 {
+		c, owner, err := ParseAllApproversOffset(offset)
+		if err != nil {
+			return err
+		}
+
+		filterA = append(filterA, bson.D{
+			{"$or", bson.A{
+				bson.D{{"contract", bson.D{{op, c}}}},
+				bson.D{{"contract", c}, {"address", bson.D{{op, owner}}}},
+			}},
+		})
+	}
+
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"$and", filterA}}}},
+		bson.D{{"$lookup", bson.D{
+			{"from", defaultColNameNFTOperator},
+			{"let", bson.D{{"contract", "$contract"}, {"address", "$address"}}},
+			{"pipeline", mongo.Pipeline{
+				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$and", bson.A{
+					bson.D{{"$eq", bson.A{"$contract", "$$contract"}}},
+					bson.D{{"$eq", bson.A{"$address", "$$address"}}},
+				}}}}}}},
+				bson.D{{"$sort", bson.D{{"height", -1}}}},
+				bson.D{{"$limit", 1}},
+				bson.D{{"$project", bson.D{{"height", 1}}}},
+			}},
+			{"as", "latest"},
+		}}},
+		bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{
+			"$height", bson.D{{"$arrayElemAt", bson.A{"$latest.height", 0}}},
+		}}}}}}},
+		// the books of the previous registration of collection are skipped.
+		bson.D{{"$lookup", bson.D{
+			{"from", defaultColNameNFTCollection},
+			{"let", bson.D{{"contract", "$contract"}}},
+			{"pipeline", mongo.Pipeline{
+				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$contract", "$$contract"}}}}}}},
+				bson.D{{"$sort", bson.D{{"height", -1}}}},
+				bson.D{{"$limit", 1}},
+				bson.D{{"$project", bson.D{{"registered_height", 1}}}},
+			}},
+			{"as", "collection"},
+		}}},
+		bson.D{{"$match", bson.D{{"$expr", bson.D{{"$gte", bson.A{
+			"$height", bson.D{{"$ifNull", bson.A{
+				bson.D{{"$arrayElemAt", bson.A{"$collection.registered_height", 0}}}, 0,
+			}}},
+		}}}}}}},
+		bson.D{{"$sort", util.NewBSONFilter("contract", sr).Add("address", sr).D()}},
+		bson.D{{"$limit", limit}},
+	}
+
+	return aggregate(
+		context.Background(), st, defaultColNameNFTOperator, pipeline,
+		func(cursor *mongo.Cursor) (bool, error) {
+			var u struct {
+				Contract string `bson:"contract"`
+				Address  string `bson:"address"`
+			}
+			if err := cursor.Decode(&u); err != nil {
+				return false, err
+			}
+
+			sta, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			operators, err := state.StateOperatorsBookValue(sta)
+			if err != nil {
+				return false, err
+			}
+
+			return callback(NFTAllApprover{
+				Contract:    u.Contract,
+				Owner:       u.Address,
+				AllApproved: *operators,
+			})
+		},
+	)
+}
+
+// NFTRestrictionMode returns the latest restriction mode of the collection;
+// types.RestrictionNone is returned when the mode has never been set.
+func NFTRestrictionMode(
+	st *currencydigest.Database,
+	contract string,
+) (types.RestrictionMode, error) {
+	filter, err := filterRegisteredAtHeight(st, util.NewBSONFilter("contract", contract), contract, mitumbase.NilHeight)
+	if err != nil {
+		return "", err
+	}
+
+	mode := types.RestrictionNone
+	if err := st.MongoClient().Find(
+		context.Background(),
+		defaultColNameNFTRestrictionMode,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			mode, err = state.StateRestrictionModeValue(sta)
+			if err != nil {
+				return false, err
+			}
+
+			return false, nil
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", -1).D()).SetLimit(1),
+	); err != nil {
+		return "", err
+	}
+
+	return mode, nil
+}
+
+// NFTRestriction returns whether account is in the restriction list of the collection.
+func NFTRestriction(
+	st *currencydigest.Database,
+	contract, account string,
+) (bool, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("address", account)
+	filter, err := filterRegisteredAtHeight(st, filter, contract, mitumbase.NilHeight)
+	if err != nil {
+		return false, err
+	}
+
+	var listed bool
+	if err := st.MongoClient().Find(
+		context.Background(),
+		defaultColNameNFTRestriction,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			listed, err = state.StateRestrictionValue(sta)
+			if err != nil {
+				return false, err
+			}
+
+			return false, nil
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", -1).D()).SetLimit(1),
+	); err != nil {
+		return false, err
+	}
+
+	return listed, nil
+}
+
+func NFTCouncil(st *currencydigest.Database, contract string) (*types.Council, error) {
+	filter, err := filterRegisteredAtHeight(st, util.NewBSONFilter("contract", contract), contract, mitumbase.NilHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var council *types.Council
+	var sta mitumbase.State
+	if err := st.MongoClient().GetByFilter(
+		defaultColNameNFTCouncil,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			council, err = state.StateCouncilValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft council for contract account %v", contract)
+	}
+
+	return council, nil
+}
+
+func NFTProposal(st *currencydigest.Database, contract, id string) (*types.Proposal, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("proposal_id", id)
+	filter, err := filterRegisteredAtHeight(st, filter, contract, mitumbase.NilHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposal *types.Proposal
+	var sta mitumbase.State
+	if err := st.MongoClient().GetByFilter(
+		defaultColNameNFTProposal,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			proposal, err = state.StateProposalValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft proposal %v for contract account %v", id, contract)
+	}
+
+	return proposal, nil
+}
+
+// NFTProposals calls callback with the latest state of each proposal of the collection, newest first.
+func NFTProposals(
+	st *currencydigest.Database,
+	contract string,
+	callback func(proposal types.Proposal) (bool, error),
+) error {
+	filter, err := filterRegisteredAtHeight(st, util.NewBSONFilter("contract", contract), contract, mitumbase.NilHeight)
+	if err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+
+	return st.MongoClient().Find(
+		context.Background(),
+		defaultColNameNFTProposal,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			proposal, err := state.StateProposalValue(sta)
+			if err != nil {
+				return false, err
+			}
+
+			if _, found := founds[proposal.ID()]; found {
+				return true, nil
+			}
+			founds[proposal.ID()] = struct{}{}
+
+			return callback(*proposal)
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", -1).D()),
+	)
+}
+
+// NFTByHash finds the nft minted with hash in a collection which enforces unique nft hash.
+func NFTByHash(st *currencydigest.Database, contract, hash string) (*types.NFT, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("hash", hash)
+	filter, err := filterRegisteredAtHeight(st, filter, contract, mitumbase.NilHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var hs *state.NFTHashStateValue
+	var sta mitumbase.State
+	if err := st.MongoClient().GetByFilter(
+		defaultColNameNFTHash,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			hs, err = state.StateNFTHashValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft hash %v for contract account %v", hash, contract)
+	}
+
+	return NFT(st, contract, strconv.FormatUint(hs.Index, 10), mitumbase.NilHeight)
+}
+
+// NFTCollectionByName resolves the collection which currently claims name, regardless of letter case.
+func NFTCollectionByName(st *currencydigest.Database, name string) (*types.Design, error) {
+	filter := util.NewBSONFilter("name", types.CollectionName(name).Normalize())
+
+	var cn *state.CollectionNameStateValue
+	var sta mitumbase.State
+	var err error
+	if err := st.MongoClient().GetByFilter(
+		defaultColNameNFTCollectionName,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			cn, err = state.StateCollectionNameValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft collection name %v", name)
+	}
+
+	if cn.Contract == nil {
+		return nil, mitumutil.ErrNotFound.Errorf("nft collection name %v", name)
+	}
+
+	return NFTCollection(st, cn.Contract.String(), mitumbase.NilHeight)
 }
@@ -8,15 +8,20 @@ import (
 	"github.com/ProtoconNet/mitum-nft/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util/encoder"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
+// NFTCollectionDoc keeps a version of collection design with the height the
+// collection was registered at, which is kept across the versions until the
+// collection is registered again.
 type NFTCollectionDoc struct {
 	mongodbstorage.BaseDoc
-	st base.State
-	de types.Design
+	st               base.State
+	de               types.Design
+	registeredHeight base.Height
 }
 
-func NewNFTCollectionDoc(st base.State, enc encoder.Encoder) (NFTCollectionDoc, error) {
+func NewNFTCollectionDoc(st base.State, enc encoder.Encoder, registeredHeight base.Height) (NFTCollectionDoc, error) {
 	de, err := state.StateCollectionValue(st)
 	if err != nil {
 		return NFTCollectionDoc{}, err
@@ -27,9 +32,10 @@ func NewNFTCollectionDoc(st base.State, enc encoder.Encoder) (NFTCollectionDoc,
 	}
 
 	return NFTCollectionDoc{
-		BaseDoc: b,
-		st:      st,
-		de:      *de,
+		BaseDoc:          b,
+		st:               st,
+		de:               *de,
+		registeredHeight: registeredHeight,
 	}, nil
 }
 
@@ -41,6 +47,7 @@ func (doc NFTCollectionDoc) MarshalBSON() ([]byte, error) {
 
 	m["contract"] = doc.de.Contract()
 	m["height"] = doc.st.Height()
+	m["registered_height"] = doc.registeredHeight
 	m["design"] = doc.de
 
 	return bsonenc.Marshal(m)
@@ -99,8 +106,108 @@ func (doc NFTDoc) MarshalBSON() ([]byte, error) {
 	m["owner"] = doc.nft.Owner()
 	m["addresses"] = doc.addresses
 	m["istoken"] = true
+	m["active"] = doc.nft.Active()
+	m["approved"] = nftApproved(doc.nft)
+	m["creators"] = nftCreators(doc.nft)
+	m["height"] = doc.st.Height()
+	m["facthash"] = hashArray
+
+	m["traits"] = nftTraits(doc.nft)
+
+	return bsonenc.Marshal(m)
+}
+
+// nftApproved returns the address approved for nft; it is empty when nobody is approved.
+func nftApproved(nft types.NFT) string {
+	if nft.Approved() == nil {
+		return ""
+	}
+
+	return nft.Approved().String()
+}
+
+// nftCreators returns the creators of nft to be matched by creator and sign status.
+func nftCreators(nft types.NFT) bson.A {
+	signers := nft.Creators().Signers()
+
+	creators := make(bson.A, len(signers))
+	for i, sgn := range signers {
+		creators[i] = bson.M{"address": sgn.Address().String(), "share": sgn.Share(), "signed": sgn.Signed()}
+	}
+
+	return creators
+}
+
+// nftTraits returns the attributes of nft to be matched by trait filters.
+func nftTraits(nft types.NFT) bson.A {
+	traits := make(bson.A, len(nft.Attributes()))
+	for i, a := range nft.Attributes() {
+		traits[i] = bson.M{"key": a.Key(), "type": a.Type(), "value": a.Value()}
+	}
+
+	return traits
+}
+
+// NFTHistoryDoc keeps a version of nft state; unlike NFTDoc, the previous
+// versions are not cleaned, so the documents of an nft make its timeline.
+type NFTHistoryDoc struct {
+	mongodbstorage.BaseDoc
+	st         base.State
+	nft        types.NFT
+	prevOwner  string
+	forced     bool
+	backfilled bool
+}
+
+func NewNFTHistoryDoc(st base.State, enc encoder.Encoder, prevOwner string, forced bool) (*NFTHistoryDoc, error) {
+	nft, err := state.StateNFTValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTHistoryDoc{
+		BaseDoc:   b,
+		st:        st,
+		nft:       *nft,
+		prevOwner: prevOwner,
+		forced:    forced,
+	}, nil
+}
+
+func (doc NFTHistoryDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashArray []string
+	for _, v := range doc.st.Operations() {
+		hashArray = append(hashArray, v.String())
+	}
+
+	m["contract"] = parsedKey[1]
+	m["nft_idx"] = doc.nft.ID()
+	m["owner"] = doc.nft.Owner()
+	m["prev_owner"] = doc.prevOwner
+	m["forced"] = doc.forced
+	m["istoken"] = true
+	m["active"] = doc.nft.Active()
 	m["height"] = doc.st.Height()
 	m["facthash"] = hashArray
+	m["traits"] = nftTraits(doc.nft)
+
+	if doc.backfilled {
+		m["backfilled"] = true
+	}
 
 	return bsonenc.Marshal(m)
 }
@@ -138,9 +245,15 @@ func (doc NFTAllApprovedDoc) MarshalBSON() ([]byte, error) {
 		return nil, err
 	}
 
+	operators := make([]string, len(doc.operators.AllApproved()))
+	for i, a := range doc.operators.AllApproved() {
+		operators[i] = a.String()
+	}
+
 	m["contract"] = parsedKey[1]
 	m["address"] = parsedKey[2]
 	m["approved"] = doc.operators
+	m["operators"] = operators
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
@@ -185,3 +298,247 @@ func (doc NFTLastIndexDoc) MarshalBSON() ([]byte, error) {
 
 	return bsonenc.Marshal(m)
 }
+
+type NFTRestrictionModeDoc struct {
+	mongodbstorage.BaseDoc
+	st   base.State
+	mode types.RestrictionMode
+}
+
+func NewNFTRestrictionModeDoc(st base.State, enc encoder.Encoder) (*NFTRestrictionModeDoc, error) {
+	mode, err := state.StateRestrictionModeValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTRestrictionModeDoc{
+		BaseDoc: b,
+		st:      st,
+		mode:    mode,
+	}, nil
+}
+
+func (doc NFTRestrictionModeDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["mode"] = doc.mode
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTRestrictionDoc struct {
+	mongodbstorage.BaseDoc
+	st     base.State
+	listed bool
+}
+
+func NewNFTRestrictionDoc(st base.State, enc encoder.Encoder) (*NFTRestrictionDoc, error) {
+	listed, err := state.StateRestrictionValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTRestrictionDoc{
+		BaseDoc: b,
+		st:      st,
+		listed:  listed,
+	}, nil
+}
+
+func (doc NFTRestrictionDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["address"] = parsedKey[2]
+	m["listed"] = doc.listed
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTCouncilDoc struct {
+	mongodbstorage.BaseDoc
+	st      base.State
+	council types.Council
+}
+
+func NewNFTCouncilDoc(st base.State, enc encoder.Encoder) (*NFTCouncilDoc, error) {
+	council, err := state.StateCouncilValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTCouncilDoc{
+		BaseDoc: b,
+		st:      st,
+		council: *council,
+	}, nil
+}
+
+func (doc NFTCouncilDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTProposalDoc struct {
+	mongodbstorage.BaseDoc
+	st       base.State
+	proposal types.Proposal
+}
+
+func NewNFTProposalDoc(st base.State, enc encoder.Encoder) (*NFTProposalDoc, error) {
+	proposal, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTProposalDoc{
+		BaseDoc:  b,
+		st:       st,
+		proposal: *proposal,
+	}, nil
+}
+
+func (doc NFTProposalDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["proposal_id"] = doc.proposal.ID()
+	m["status"] = doc.proposal.Status()
+	m["expire_height"] = doc.proposal.ExpireHeight()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTHashDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	hs state.NFTHashStateValue
+}
+
+func NewNFTHashDoc(st base.State, enc encoder.Encoder) (*NFTHashDoc, error) {
+	hs, err := state.StateNFTHashValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTHashDoc{
+		BaseDoc: b,
+		st:      st,
+		hs:      *hs,
+	}, nil
+}
+
+func (doc NFTHashDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["hash"] = doc.hs.Hash.String()
+	m["nft_idx"] = doc.hs.Index
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTCollectionNameDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	cn state.CollectionNameStateValue
+}
+
+func NewNFTCollectionNameDoc(st base.State, enc encoder.Encoder) (*NFTCollectionNameDoc, error) {
+	cn, err := state.StateCollectionNameValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTCollectionNameDoc{
+		BaseDoc: b,
+		st:      st,
+		cn:      *cn,
+	}, nil
+}
+
+func (doc NFTCollectionNameDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	var contract string
+	if doc.cn.Contract != nil {
+		contract = doc.cn.Contract.String()
+	}
+
+	m["name"] = doc.cn.Name.Normalize()
+	m["contract"] = contract
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -1,12 +1,41 @@
 package digest
 
 import (
+	"regexp"
 	"strconv"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
 
 	"go.mongodb.org/mongo-driver/bson"
 )
 
-func buildNFTsFilterByContract(contract, facthash, offset string, reverse bool) (bson.D, error) {
+// TraitFilter matches nfts which have the attribute of key with value.
+type TraitFilter struct {
+	Key   string
+	Value string
+}
+
+// ParseTraitQuery parses the trait queries of "<key>:<value>".
+func ParseTraitQuery(qs []string) ([]TraitFilter, error) {
+	traits := make([]TraitFilter, len(qs))
+	for i, q := range qs {
+		l := strings.SplitN(q, ":", 2)
+		if len(l) != 2 || len(l[0]) < 1 {
+			return nil, errors.Errorf("invalid trait query, %q", q)
+		}
+
+		traits[i] = TraitFilter{Key: l[0], Value: l[1]}
+	}
+
+	return traits, nil
+}
+
+func buildNFTsFilterByContract(
+	contract, facthash, offset string, reverse bool, traits []TraitFilter,
+) (bson.D, error) {
 	filterA := bson.A{}
 
 	// filter fot matching collection
@@ -42,12 +71,205 @@ func buildNFTsFilterByContract(contract, facthash, offset string, reverse bool)
 		filterA = append(filterA, filterFactHash)
 	}
 
-	filter := bson.D{}
+	for _, t := range traits {
+		filterTrait := bson.D{
+			{"traits", bson.D{{"$elemMatch", bson.D{{"key", t.Key}, {"value", t.Value}}}}},
+		}
+		filterA = append(filterA, filterTrait)
+	}
+
+	match := bson.D{}
 	if len(filterA) > 0 {
-		filter = bson.D{
+		match = bson.D{
 			{"$and", filterA},
 		}
 	}
 
+	return match, nil
+}
+
+// ParseAccountNFTsOffset parses the offset of "<contract>,<nft idx>" for nfts of an account.
+func ParseAccountNFTsOffset(offset string) (string, uint64, error) {
+	l := strings.SplitN(offset, ",", 2)
+	if len(l) != 2 || len(l[0]) < 1 {
+		return "", 0, errors.Errorf("invalid account nfts offset, %q", offset)
+	}
+
+	idx, err := strconv.ParseUint(l[1], 10, 64)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "invalid account nfts offset, %q", offset)
+	}
+
+	return l[0], idx, nil
+}
+
+// buildNFTsFilterByOwner matches the active nfts of owner; burned nfts keep
+// their last owner, but are not held by owner any more.
+func buildNFTsFilterByOwner(
+	owner, contract, offset string, reverse bool,
+) (bson.D, error) {
+	return buildNFTsFilterByAccount(bson.D{
+		{"owner", owner},
+		{"active", bson.D{{"$ne", false}}},
+	}, contract, offset, reverse)
+}
+
+// buildNFTsFilterByApproved matches the active nfts which approved can transfer.
+func buildNFTsFilterByApproved(
+	approved, contract, offset string, reverse bool,
+) (bson.D, error) {
+	return buildNFTsFilterByAccount(bson.D{
+		{"approved", approved},
+		{"active", bson.D{{"$ne", false}}},
+	}, contract, offset, reverse)
+}
+
+// buildNFTsFilterByCreator matches the nfts of creator; with pending, only the
+// active nfts which creator has not signed yet.
+func buildNFTsFilterByCreator(
+	creator, contract, offset string, pending, reverse bool,
+) (bson.D, error) {
+	if !pending {
+		return buildNFTsFilterByAccount(bson.D{{"creators.address", creator}}, contract, offset, reverse)
+	}
+
+	return buildNFTsFilterByAccount(bson.D{
+		{"creators", bson.D{{"$elemMatch", bson.D{{"address", creator}, {"signed", false}}}}},
+		{"active", bson.D{{"$ne", false}}},
+	}, contract, offset, reverse)
+}
+
+// buildNFTsFilterByAccount matches the nfts by filterAccount.
+func buildNFTsFilterByAccount(
+	filterAccount bson.D, contract, offset string, reverse bool,
+) (bson.D, error) {
+	filterA := bson.A{}
+
+	filterToken := bson.D{{"istoken", true}}
+	filterA = append(filterA, filterToken)
+	filterA = append(filterA, filterAccount)
+
+	if len(contract) > 0 {
+		filterContract := bson.D{{"contract", contract}}
+		filterA = append(filterA, filterContract)
+	}
+
+	// nfts are sorted by contract and nft idx, so offset is compared with both
+	if len(offset) > 0 {
+		c, idx, err := ParseAccountNFTsOffset(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		op := "$gt"
+		if reverse {
+			op = "$lt"
+		}
+
+		filterOffset := bson.D{
+			{"$or", bson.A{
+				bson.D{{"contract", bson.D{{op, c}}}},
+				bson.D{{"contract", c}, {"nft_idx", bson.D{{op, idx}}}},
+			}},
+		}
+		filterA = append(filterA, filterOffset)
+	}
+
+	filter := bson.D{
+		{"$and", filterA},
+	}
+
 	return filter, nil
 }
+
+// CollectionsFilter narrows the registered collections; empty fields match every collection.
+type CollectionsFilter struct {
+	Creator    string
+	Active     *bool
+	NamePrefix string
+}
+
+// ParseCollectionsOffset parses the offset of "<registered height>,<contract>" for collections.
+func ParseCollectionsOffset(offset string) (int64, string, error) {
+	l := strings.SplitN(offset, ",", 2)
+	if len(l) != 2 || len(l[1]) < 1 {
+		return 0, "", errors.Errorf("invalid collections offset, %q", offset)
+	}
+
+	h, err := strconv.ParseInt(l[0], 10, 64)
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "invalid collections offset, %q", offset)
+	}
+
+	return h, l[1], nil
+}
+
+func buildCollectionsFilter(filter CollectionsFilter, offset string, reverse bool) (bson.D, error) {
+	filterA := bson.A{}
+
+	if len(filter.Creator) > 0 {
+		filterA = append(filterA, bson.D{{"design.creator", filter.Creator}})
+	}
+
+	if filter.Active != nil {
+		filterA = append(filterA, bson.D{{"design.active", *filter.Active}})
+	}
+
+	// collection names are unique regardless of letter case
+	if len(filter.NamePrefix) > 0 {
+		filterA = append(filterA, bson.D{{"design.policy.name", bson.D{
+			{"$regex", "^" + regexp.QuoteMeta(filter.NamePrefix)},
+			{"$options", "i"},
+		}}})
+	}
+
+	// collections are sorted by registered height and contract, so offset is compared with both
+	if len(offset) > 0 {
+		h, contract, err := ParseCollectionsOffset(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		op := "$gt"
+		if reverse {
+			op = "$lt"
+		}
+
+		filterA = append(filterA, bson.D{
+			{"$or", bson.A{
+				bson.D{{"registered_height", bson.D{{op, h}}}},
+				bson.D{{"registered_height", h}, {"contract", bson.D{{op, contract}}}},
+			}},
+		})
+	}
+
+	match := bson.D{}
+	if len(filterA) > 0 {
+		match = bson.D{
+			{"$and", filterA},
+		}
+	}
+
+	return match, nil
+}
+
+// filterAtHeight matches the versions of documents valid at height; with
+// base.NilHeight, filter is returned as it is, so the latest version is matched.
+func filterAtHeight(filter *util.BSONFilter, height base.Height) *util.BSONFilter {
+	if height <= base.NilHeight {
+		return filter
+	}
+
+	return filter.Add("height", bson.D{{"$lte", height}})
+}
+
+// ParseAllApproversOffset parses the offset of "<contract>,<owner>" for the
+// owners who approved an account for all their nfts.
+func ParseAllApproversOffset(offset string) (string, string, error) {
+	l := strings.SplitN(offset, ",", 2)
+	if len(l) != 2 || len(l[0]) < 1 || len(l[1]) < 1 {
+		return "", "", errors.Errorf("invalid all approvers offset, %q", offset)
+	}
+
+	return l[0], l[1], nil
+}
@@ -0,0 +1,94 @@
+package digest
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func hasTestFilterCond(filter bson.D, cond bson.D) bool {
+	for _, e := range filter {
+		if e.Key != "$and" {
+			continue
+		}
+
+		conds, ok := e.Value.(bson.A)
+		if !ok {
+			return false
+		}
+
+		for i := range conds {
+			if reflect.DeepEqual(conds[i], cond) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func TestBuildNFTsFilterActive(t *testing.T) {
+	// NOTE burned nfts keep their owner and approved, but are not held any more.
+	owner, err := buildNFTsFilterByOwner("account", "contract", "", false)
+	if err != nil {
+		t.Fatalf("owner: unexpected error, %v", err)
+	}
+
+	if !hasTestFilterCond(owner, bson.D{{"owner", "account"}, {"active", bson.D{{"$ne", false}}}}) {
+		t.Errorf("owner: expected active condition, %v", owner)
+	}
+
+	approved, err := buildNFTsFilterByApproved("account", "", "contract,3", true)
+	if err != nil {
+		t.Fatalf("approved: unexpected error, %v", err)
+	}
+
+	if !hasTestFilterCond(approved, bson.D{{"approved", "account"}, {"active", bson.D{{"$ne", false}}}}) {
+		t.Errorf("approved: expected active condition, %v", approved)
+	}
+}
+
+func TestBuildNFTsFilterByCreator(t *testing.T) {
+	filter, err := buildNFTsFilterByCreator("account", "", "", false, false)
+	if err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	// NOTE burned nfts are still created by creator.
+	if !hasTestFilterCond(filter, bson.D{{"creators.address", "account"}}) {
+		t.Errorf("expected creator condition, %v", filter)
+	}
+
+	pending, err := buildNFTsFilterByCreator("account", "", "", true, false)
+	if err != nil {
+		t.Fatalf("pending: unexpected error, %v", err)
+	}
+
+	if !hasTestFilterCond(pending, bson.D{
+		{"creators", bson.D{{"$elemMatch", bson.D{{"address", "account"}, {"signed", false}}}}},
+		{"active", bson.D{{"$ne", false}}},
+	}) {
+		t.Errorf("pending: expected unsigned active condition, %v", pending)
+	}
+}
+
+func TestBuildNFTsFilterByAccountOffset(t *testing.T) {
+	filter, err := buildNFTsFilterByOwner("account", "", "contract,3", true)
+	if err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	if !hasTestFilterCond(filter, bson.D{{"$or", bson.A{
+		bson.D{{"contract", bson.D{{"$lt", "contract"}}}},
+		bson.D{{"contract", "contract"}, {"nft_idx", bson.D{{"$lt", uint64(3)}}}},
+	}}}) {
+		t.Errorf("expected reversed offset condition, %v", filter)
+	}
+
+	for _, offset := range []string{"contract", ",3", "contract,a"} {
+		if _, err := buildNFTsFilterByOwner("account", "", offset, false); err == nil {
+			t.Errorf("offset %q: expected error", offset)
+		}
+	}
+}
@@ -23,13 +23,33 @@ import (
 )
 
 var (
-	HandlerPathNFTAllApproved = `/nft/{contract:(?i)` + types.REStringAddressString + `}/account/{address:(?i)` + types.REStringAddressString + `}/allapproved` // revive:disable-line:line-length-limit
-	HandlerPathNFTCollection  = `/nft/{contract:(?i)` + types.REStringAddressString + `}`
-	HandlerPathNFT            = `/nft/{contract:(?i)` + types.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}`
-	HandlerPathNFTs           = `/nft/{contract:(?i)` + types.REStringAddressString + `}/nfts`
-	HandlerPathNFTCount       = `/nft/{contract:(?i)` + types.REStringAddressString + `}/totalsupply`
+	HandlerPathNFTAllApproved      = `/nft/{contract:(?i)` + types.REStringAddressString + `}/account/{address:(?i)` + types.REStringAddressString + `}/allapproved` // revive:disable-line:line-length-limit
+	HandlerPathNFTCollections      = `/nft/collections`
+	HandlerPathNFTCollectionByName = `/nft/name/{name:.+}`
+	HandlerPathNFTCollection       = `/nft/{contract:(?i)` + types.REStringAddressString + `}`
+	HandlerPathNFT                 = `/nft/{contract:(?i)` + types.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}`
+	HandlerPathNFTHistory          = `/nft/{contract:(?i)` + types.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}/history`
+	HandlerPathNFTOperations       = `/nft/{contract:(?i)` + types.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}/operations`
+	HandlerPathNFTByHash           = `/nft/{contract:(?i)` + types.REStringAddressString + `}/hash/{hash:.+}`
+	HandlerPathNFTs                = `/nft/{contract:(?i)` + types.REStringAddressString + `}/nfts`
+	HandlerPathNFTCount            = `/nft/{contract:(?i)` + types.REStringAddressString + `}/totalsupply`
+	HandlerPathNFTStats            = `/nft/{contract:(?i)` + types.REStringAddressString + `}/stats`
+	HandlerPathNFTHolders          = `/nft/{contract:(?i)` + types.REStringAddressString + `}/holders`
+	HandlerPathNFTCouncil          = `/nft/{contract:(?i)` + types.REStringAddressString + `}/council`
+	HandlerPathNFTProposals        = `/nft/{contract:(?i)` + types.REStringAddressString + `}/proposals`
+	HandlerPathNFTProposal         = `/nft/{contract:(?i)` + types.REStringAddressString + `}/proposal/{proposal_id:[A-Za-z0-9]+}`
+	HandlerPathNFTPermitted        = `/nft/{contract:(?i)` + types.REStringAddressString + `}/account/{address:(?i)` + types.REStringAddressString + `}/permitted` // revive:disable-line:line-length-limit
+	HandlerPathAccountNFTs         = `/account/{address:(?i)` + types.REStringAddressString + `}/nfts`
+	HandlerPathAccountApprovedNFTs = `/account/{address:(?i)` + types.REStringAddressString + `}/approvednfts`
+	HandlerPathAccountAllApprovers = `/account/{address:(?i)` + types.REStringAddressString + `}/allapprovers`
+	HandlerPathAccountCreated      = `/account/{address:(?i)` + types.REStringAddressString + `}/created`
+	HandlerPathAccountPendingSigns = `/account/{address:(?i)` + types.REStringAddressString + `}/pending-signatures`
 )
 
+// DefaultStatsExpire is the default cache duration of collection stats, which
+// are aggregated over every nft of collection.
+var DefaultStatsExpire = time.Second * 30
+
 func init() {
 	if b, err := currencydigest.JSON.Marshal(currencydigest.UnknownProblem); err != nil {
 		panic(err)
@@ -53,6 +73,7 @@ type Handlers struct {
 	itemsLimiter    func(string /* request type */) int64
 	rg              *singleflight.Group
 	expireNotFilled time.Duration
+	expireStats     time.Duration
 }
 
 func NewHandlers(
@@ -82,6 +103,7 @@ func NewHandlers(
 		itemsLimiter:    currencydigest.DefaultItemsLimiter,
 		rg:              &singleflight.Group{},
 		expireNotFilled: time.Second * 3,
+		expireStats:     DefaultStatsExpire,
 	}
 }
 
@@ -105,6 +127,15 @@ func (hd *Handlers) SetLimiter(f func(string) int64) *Handlers {
 	return hd
 }
 
+// SetStatsExpire sets how long the collection stats are cached.
+func (hd *Handlers) SetStatsExpire(d time.Duration) *Handlers {
+	if d > 0 {
+		hd.expireStats = d
+	}
+
+	return hd
+}
+
 func (hd *Handlers) Cache() currencydigest.Cache {
 	return hd.cache
 }
@@ -119,16 +150,48 @@ func (hd *Handlers) Handler() http.Handler {
 
 func (hd *Handlers) setHandlers() {
 	get := 1000
+	_ = hd.setHandler(HandlerPathNFTCollections, hd.handleNFTCollections, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTCollectionByName, hd.handleNFTCollectionByName, true, get, get).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathNFTCollection, hd.handleNFTCollection, true, get, get).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathNFTs, hd.handleNFTs, true, get, get).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathNFTCount, hd.handleNFTCount, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTStats, hd.handleNFTStats, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTHolders, hd.handleNFTHolders, false, get, get).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathNFTAllApproved, hd.handleNFTOperators, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTCouncil, hd.handleNFTCouncil, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTProposals, hd.handleNFTProposals, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTProposal, hd.handleNFTProposal, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTPermitted, hd.handleNFTPermitted, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTByHash, hd.handleNFTByHash, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTHistory, hd.handleNFTHistory, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathNFTOperations, hd.handleNFTOperations, true, get, get).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathNFT, hd.handleNFT, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountNFTs, hd.handleAccountNFTs, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountApprovedNFTs, hd.handleAccountApprovedNFTs, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountAllApprovers, hd.handleAccountAllApprovers, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountCreated, hd.handleAccountCreated, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountPendingSigns, hd.handleAccountPendingSignatures, true, get, get).
+		Methods(http.MethodOptions, "GET")
 }
 
 func (hd *Handlers) setHandler(prefix string, h network.HTTPHandlerFunc, useCache bool, rps, burst int) *mux.Route {
@@ -5,81 +5,42 @@ import (
 	"github.com/ProtoconNet/mitum-nft/types"
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
 )
 
 func (hd *Handlers) handleNFT(w http.ResponseWriter, r *http.Request) {
-	cachekey := currencydigest.CacheKeyPath(r)
-	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
-		return
-	}
-
 	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
 	if err != nil {
 		currencydigest.HTTP2ProblemWithError(w, err, status)
 		return
 	}
 
-	id, err, status := currencydigest.ParseRequest(w, r, "nft_idx")
+	height, err, status := hd.parseHistoryHeightQuery(r, contract)
 	if err != nil {
 		currencydigest.HTTP2ProblemWithError(w, err, status)
-		return
-	}
-
-	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		return hd.handleNFTInGroup(contract, id)
-	}); err != nil {
-		currencydigest.HTTP2HandleError(w, err)
-	} else {
-		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
-		if !shared {
-			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
-		}
-	}
-}
-
-func (hd *Handlers) handleNFTInGroup(contract, id string) (interface{}, error) {
-	switch nft, err := NFT(hd.database, contract, id); {
-	case err != nil:
-		return nil, err
-	default:
-		hal, err := hd.buildNFTHal(contract, *nft)
-		if err != nil {
-			return nil, err
-		}
-		return hd.encoder.Marshal(hal)
-	}
-}
 
-func (hd *Handlers) buildNFTHal(contract string, nft types.NFT) (currencydigest.Hal, error) {
-	h, err := hd.combineURL(HandlerPathNFT, "contract", contract, "nft_idx", strconv.FormatUint(nft.ID(), 10))
-	if err != nil {
-		return nil, err
+		return
 	}
 
-	hal := currencydigest.NewBaseHal(nft, currencydigest.NewHalLink(h, nil))
-
-	return hal, nil
-}
-
-func (hd *Handlers) handleNFTCollection(w http.ResponseWriter, r *http.Request) {
-	cachekey := currencydigest.CacheKeyPath(r)
+	cachekey := currencydigest.CacheKey(r.URL.Path, stringHeightQuery(height))
 	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
 	}
 
-	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	id, err, status := currencydigest.ParseRequest(w, r, "nft_idx")
 	if err != nil {
 		currencydigest.HTTP2ProblemWithError(w, err, status)
-
 		return
 	}
 
 	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		return hd.handleNFTCollectionInGroup(contract)
+		return hd.handleNFTInGroup(contract, id, height)
 	}); err != nil {
 		currencydigest.HTTP2HandleError(w, err)
 	} else {
@@ -90,12 +51,12 @@ func (hd *Handlers) handleNFTCollection(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-func (hd *Handlers) handleNFTCollectionInGroup(contract string) (interface{}, error) {
-	switch design, err := NFTCollection(hd.database, contract); {
+func (hd *Handlers) handleNFTInGroup(contract, id string, height base.Height) (interface{}, error) {
+	switch nft, err := NFT(hd.database, contract, id, height); {
 	case err != nil:
 		return nil, err
 	default:
-		hal, err := hd.buildNFTCollectionHal(contract, *design)
+		hal, err := hd.buildNFTHal(contract, *nft)
 		if err != nil {
 			return nil, err
 		}
@@ -103,22 +64,21 @@ func (hd *Handlers) handleNFTCollectionInGroup(contract string) (interface{}, er
 	}
 }
 
-func (hd *Handlers) buildNFTCollectionHal(contract string, design types.Design) (currencydigest.Hal, error) {
-	h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+func (hd *Handlers) buildNFTHal(contract string, nft types.NFT) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathNFT, "contract", contract, "nft_idx", strconv.FormatUint(nft.ID(), 10))
 	if err != nil {
 		return nil, err
 	}
 
-	hal := currencydigest.NewBaseHal(design, currencydigest.NewHalLink(h, nil))
+	hal := currencydigest.NewBaseHal(nft, currencydigest.NewHalLink(h, nil))
 
 	return hal, nil
 }
 
-func (hd *Handlers) handleNFTs(w http.ResponseWriter, r *http.Request) {
+func (hd *Handlers) handleNFTHistory(w http.ResponseWriter, r *http.Request) {
 	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
 	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
 	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
-	facthash := currencydigest.ParseStringQuery(r.URL.Query().Get("facthash"))
 
 	cachekey := currencydigest.CacheKey(
 		r.URL.Path, currencydigest.StringOffsetQuery(offset),
@@ -132,14 +92,29 @@ func (hd *Handlers) handleNFTs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	id, err, status := currencydigest.ParseRequest(w, r, "nft_idx")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if len(offset) > 0 {
+		if _, err := strconv.ParseInt(offset, 10, 64); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
 	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleNFTsInGroup(contract, facthash, offset, reverse, limit)
+		i, filled, err := hd.handleNFTHistoryInGroup(contract, id, offset, reverse, limit)
 
 		return []interface{}{i, filled}, err
 	})
 
 	if err != nil {
-		hd.Log().Err(err).Str("contract", contract).Msg("failed to get nfts")
+		hd.Log().Err(err).Str("contract", contract).Str("nft_idx", id).Msg("failed to get nft history")
 		currencydigest.HTTP2HandleError(w, err)
 
 		return
@@ -165,37 +140,41 @@ func (hd *Handlers) handleNFTs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (hd *Handlers) handleNFTsInGroup(
-	contract, facthash, offset string,
+func (hd *Handlers) handleNFTHistoryInGroup(
+	contract, id, offset string,
 	reverse bool,
 	l int64,
 ) ([]byte, bool, error) {
 	var limit int64
 	if l < 0 {
-		limit = hd.itemsLimiter("collection-nfts")
+		limit = hd.itemsLimiter("nft-history")
 	} else {
 		limit = l
 	}
 
 	var vas []currencydigest.Hal
-	if err := NFTsByCollection(
-		hd.database, contract, facthash, offset, reverse, limit,
-		func(nft types.NFT, st base.State) (bool, error) {
-			hal, err := hd.buildNFTHal(contract, nft)
+	var nextoffset string
+	if err := NFTHistories(
+		hd.database, contract, id, offset, reverse, limit,
+		func(history NFTHistory) (bool, error) {
+			h, err := hd.combineURL(HandlerPathNFT, "contract", contract, "nft_idx", id)
 			if err != nil {
 				return false, err
 			}
-			vas = append(vas, hal)
+
+			vas = append(vas, currencydigest.NewBaseHal(history, currencydigest.NewHalLink(h, nil)))
+			nextoffset = history.Height.String()
 
 			return true, nil
 		},
 	); err != nil {
-		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "nft tokens by contract, %s", contract)
+		return nil, false, mitumutil.ErrNotFound.WithMessage(
+			err, "nft history for contract account %s, nft idx %s", contract, id)
 	} else if len(vas) < 1 {
-		return nil, false, mitumutil.ErrNotFound.Errorf("nft tokens by contract, %s", contract)
+		return nil, false, mitumutil.ErrNotFound.Errorf("nft history for contract account %s, nft idx %s", contract, id)
 	}
 
-	i, err := hd.buildNFTsHal(contract, vas, offset, reverse)
+	i, err := hd.buildNFTHistoryHal(contract, id, vas, offset, nextoffset, reverse)
 	if err != nil {
 		return nil, false, err
 	}
@@ -204,9 +183,64 @@ func (hd *Handlers) handleNFTsInGroup(
 	return b, int64(len(vas)) == limit, err
 }
 
-func (hd *Handlers) handleNFTCount(w http.ResponseWriter, r *http.Request) {
+func (hd *Handlers) buildNFTHistoryHal(
+	contract, id string,
+	vas []currencydigest.Hal,
+	offset, nextoffset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathNFTHistory, "contract", contract, "nft_idx", id)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathNFT, "contract", contract, "nft_idx", id)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("nft", currencydigest.NewHalLink(h, nil))
+
+	if len(nextoffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextoffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink(
+		"reverse",
+		currencydigest.NewHalLink(
+			currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)),
+			nil,
+		),
+	)
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTOperations(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+
 	cachekey := currencydigest.CacheKey(
-		r.URL.Path,
+		r.URL.Path, currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
 	)
 
 	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
@@ -216,84 +250,104 @@ func (hd *Handlers) handleNFTCount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	id, err, status := currencydigest.ParseRequest(w, r, "nft_idx")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if len(offset) > 0 {
+		if _, err := strconv.ParseInt(offset, 10, 64); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
 	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, err := hd.handleNFTCountInGroup(contract)
+		i, filled, err := hd.handleNFTOperationsInGroup(contract, id, offset, reverse, limit)
 
-		return i, err
+		return []interface{}{i, filled}, err
 	})
 
 	if err != nil {
-		hd.Log().Err(err).Str("contract", contract).Msg("failed to count nft")
+		hd.Log().Err(err).Str("contract", contract).Str("nft_idx", id).Msg("failed to get nft operations")
 		currencydigest.HTTP2HandleError(w, err)
 
 		return
 	}
 
-	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
 
 	if !shared {
 		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
 		currencydigest.HTTP2WriteCache(w, cachekey, expire)
 	}
 }
 
-func (hd *Handlers) handleNFTCountInGroup(
-	contract string,
-) ([]byte, error) {
-	count, err := NFTCountByCollection(
-		hd.database, contract,
-	)
-	if err != nil {
-		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft count by contract, %s", contract)
-	}
-
-	i, err := hd.buildNFTCountHal(contract, count)
-	if err != nil {
-		return nil, err
+func (hd *Handlers) handleNFTOperationsInGroup(
+	contract, id, offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("nft-operations")
+	} else {
+		limit = l
 	}
 
-	b, err := hd.encoder.Marshal(i)
-	return b, err
-}
-
-func (hd *Handlers) buildNFTCountHal(
-	contract string,
-	count int64,
-) (currencydigest.Hal, error) {
-	baseSelf, err := hd.combineURL(HandlerPathNFTCount, "contract", contract)
-	if err != nil {
-		return nil, err
-	}
+	var vas []currencydigest.Hal
+	var nextoffset string
+	if err := NFTOperations(
+		hd.database, contract, id, offset, reverse, limit,
+		func(op NFTOperation) (bool, error) {
+			h, err := hd.combineURL(currencydigest.HandlerPathOperation, "hash", op.FactHash)
+			if err != nil {
+				return false, err
+			}
 
-	self := baseSelf
+			vas = append(vas, currencydigest.NewBaseHal(op, currencydigest.NewHalLink(h, nil)))
+			nextoffset = op.Height.String()
 
-	var m struct {
-		Contract string `json:"contract"`
-		NFTCount int64  `json:"nft_total_supply"`
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(
+			err, "nft operations for contract account %s, nft idx %s", contract, id)
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("nft operations for contract account %s, nft idx %s", contract, id)
 	}
 
-	m.Contract = contract
-	m.NFTCount = count
-
-	var hal currencydigest.Hal
-	hal = currencydigest.NewBaseHal(m, currencydigest.NewHalLink(self, nil))
-
-	h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+	i, err := hd.buildNFTOperationsHal(contract, id, vas, offset, nextoffset, reverse)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	hal = hal.AddLink("collection", currencydigest.NewHalLink(h, nil))
 
-	return hal, nil
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) >= limit, err
 }
 
-func (hd *Handlers) buildNFTsHal(
-	contract string,
+func (hd *Handlers) buildNFTOperationsHal(
+	contract, id string,
 	vas []currencydigest.Hal,
-	offset string,
+	offset, nextoffset string,
 	reverse bool,
 ) (currencydigest.Hal, error) {
-	baseSelf, err := hd.combineURL(HandlerPathNFTs, "contract", contract)
+	baseSelf, err := hd.combineURL(HandlerPathNFTOperations, "contract", contract, "nft_idx", id)
 	if err != nil {
 		return nil, err
 	}
@@ -309,18 +363,11 @@ func (hd *Handlers) buildNFTsHal(
 	var hal currencydigest.Hal
 	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
 
-	h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+	h, err := hd.combineURL(HandlerPathNFT, "contract", contract, "nft_idx", id)
 	if err != nil {
 		return nil, err
 	}
-	hal = hal.AddLink("collection", currencydigest.NewHalLink(h, nil))
-
-	var nextoffset string
-
-	if len(vas) > 0 {
-		va := vas[len(vas)-1].Interface().(types.NFT)
-		nextoffset = strconv.FormatUint(va.ID(), 10)
-	}
+	hal = hal.AddLink("nft", currencydigest.NewHalLink(h, nil))
 
 	if len(nextoffset) > 0 {
 		next := baseSelf
@@ -344,7 +391,7 @@ func (hd *Handlers) buildNFTsHal(
 	return hal, nil
 }
 
-func (hd *Handlers) handleNFTOperators(w http.ResponseWriter, r *http.Request) {
+func (hd *Handlers) handleNFTByHash(w http.ResponseWriter, r *http.Request) {
 	cachekey := currencydigest.CacheKeyPath(r)
 	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
@@ -353,19 +400,17 @@ func (hd *Handlers) handleNFTOperators(w http.ResponseWriter, r *http.Request) {
 	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
 	if err != nil {
 		currencydigest.HTTP2ProblemWithError(w, err, status)
-
 		return
 	}
 
-	account, err, status := currencydigest.ParseRequest(w, r, "address")
+	hash, err, status := currencydigest.ParseRequest(w, r, "hash")
 	if err != nil {
 		currencydigest.HTTP2ProblemWithError(w, err, status)
-
 		return
 	}
 
 	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		return hd.handleNFTOperatorsInGroup(contract, account)
+		return hd.handleNFTByHashInGroup(contract, hash)
 	}); err != nil {
 		currencydigest.HTTP2HandleError(w, err)
 	} else {
@@ -376,12 +421,12 @@ func (hd *Handlers) handleNFTOperators(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (hd *Handlers) handleNFTOperatorsInGroup(contract, account string) (interface{}, error) {
-	switch operators, err := NFTOperators(hd.database, contract, account); {
+func (hd *Handlers) handleNFTByHashInGroup(contract, hash string) (interface{}, error) {
+	switch nft, err := NFTByHash(hd.database, contract, hash); {
 	case err != nil:
 		return nil, err
 	default:
-		hal, err := hd.buildNFTOperatorsHal(contract, account, *operators)
+		hal, err := hd.buildNFTHal(contract, *nft)
 		if err != nil {
 			return nil, err
 		}
@@ -389,13 +434,1715 @@ func (hd *Handlers) handleNFTOperatorsInGroup(contract, account string) (interfa
 	}
 }
 
-func (hd *Handlers) buildNFTOperatorsHal(contract, account string, operators types.AllApprovedBook) (currencydigest.Hal, error) {
-	h, err := hd.combineURL(HandlerPathNFTAllApproved, "contract", contract, "address", account)
+func (hd *Handlers) handleNFTCollection(w http.ResponseWriter, r *http.Request) {
+	height, err := parseHeightQuery(r)
 	if err != nil {
-		return nil, err
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
 	}
 
-	hal := currencydigest.NewBaseHal(operators, currencydigest.NewHalLink(h, nil))
+	cachekey := currencydigest.CacheKey(r.URL.Path, stringHeightQuery(height))
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
 
-	return hal, nil
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTCollectionInGroup(contract, height)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTCollectionInGroup(contract string, height base.Height) (interface{}, error) {
+	switch design, err := NFTCollection(hd.database, contract, height); {
+	case err != nil:
+		return nil, err
+	default:
+		hal, err := hd.buildNFTCollectionHal(contract, *design)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) handleNFTCollectionByName(w http.ResponseWriter, r *http.Request) {
+	cachekey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	name, err, status := currencydigest.ParseRequest(w, r, "name")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTCollectionByNameInGroup(name)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTCollectionByNameInGroup(name string) (interface{}, error) {
+	switch design, err := NFTCollectionByName(hd.database, name); {
+	case err != nil:
+		return nil, err
+	default:
+		hal, err := hd.buildNFTCollectionHal(design.Contract().String(), *design)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildNFTCollectionHal(contract string, design types.Design) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(design, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTCollections(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+
+	filter := CollectionsFilter{
+		Creator:    currencydigest.ParseStringQuery(r.URL.Query().Get("creator")),
+		NamePrefix: currencydigest.ParseStringQuery(r.URL.Query().Get("name")),
+	}
+
+	if s := currencydigest.ParseStringQuery(r.URL.Query().Get("active")); len(s) > 0 {
+		active, err := strconv.ParseBool(s)
+		if err != nil {
+			currencydigest.HTTP2ProblemWithError(w, errors.Errorf("invalid active query, %q", s), http.StatusBadRequest)
+
+			return
+		}
+		filter.Active = &active
+	}
+
+	if len(offset) > 0 {
+		if _, _, err := ParseCollectionsOffset(offset); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, stringCollectionsFilterQuery(filter),
+		currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleNFTCollectionsInGroup(filter, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Msg("failed to get nft collections")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleNFTCollectionsInGroup(
+	filter CollectionsFilter,
+	offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("collections")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	var nextoffset string
+	if err := NFTCollections(
+		hd.database, filter, offset, reverse, limit,
+		func(collection RegisteredCollection, _ base.State) (bool, error) {
+			contract := collection.Design.Contract().String()
+
+			h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+			if err != nil {
+				return false, err
+			}
+
+			vas = append(vas, currencydigest.NewBaseHal(collection, currencydigest.NewHalLink(h, nil)))
+			nextoffset = strconv.FormatInt(collection.RegisteredHeight.Int64(), 10) + "," + contract
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "nft collections")
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("nft collections")
+	}
+
+	i, err := hd.buildNFTCollectionsHal(filter, vas, offset, nextoffset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildNFTCollectionsHal(
+	filter CollectionsFilter,
+	vas []currencydigest.Hal,
+	offset, nextoffset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathNFTCollections)
+	if err != nil {
+		return nil, err
+	}
+
+	if q := stringCollectionsFilterQuery(filter); len(q) > 0 {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, q)
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	if len(nextoffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextoffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink(
+		"reverse",
+		currencydigest.NewHalLink(
+			currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)),
+			nil,
+		),
+	)
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTs(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+	facthash := currencydigest.ParseStringQuery(r.URL.Query().Get("facthash"))
+	traitqs := r.URL.Query()["trait"]
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	height, err, status := hd.parseHistoryHeightQuery(r, contract)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+		stringTraitQuery(traitqs), stringHeightQuery(height),
+	)
+
+	traits, err := ParseTraitQuery(traitqs)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleNFTsInGroup(contract, facthash, offset, reverse, limit, traits, height)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Str("contract", contract).Msg("failed to get nfts")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleNFTsInGroup(
+	contract, facthash, offset string,
+	reverse bool,
+	l int64,
+	traits []TraitFilter,
+	height base.Height,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("collection-nfts")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	if err := NFTsByCollection(
+		hd.database, contract, facthash, offset, reverse, limit, traits, height,
+		func(nft types.NFT, st base.State) (bool, error) {
+			hal, err := hd.buildNFTHal(contract, nft)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "nft tokens by contract, %s", contract)
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("nft tokens by contract, %s", contract)
+	}
+
+	i, err := hd.buildNFTsHal(contract, vas, offset, reverse, traits, height)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) handleNFTCount(w http.ResponseWriter, r *http.Request) {
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	height, err, status := hd.parseHistoryHeightQuery(r, contract)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, stringHeightQuery(height),
+	)
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, err := hd.handleNFTCountInGroup(contract, height)
+
+		return i, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Str("contract", contract).Msg("failed to count nft")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if height > base.NilHeight {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleNFTCountInGroup(
+	contract string,
+	height base.Height,
+) ([]byte, error) {
+	supply, err := NFTSupplyByCollection(
+		hd.database, contract, height,
+	)
+	if err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft count by contract, %s", contract)
+	}
+
+	i, err := hd.buildNFTCountHal(contract, *supply)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, err
+}
+
+func (hd *Handlers) buildNFTCountHal(
+	contract string,
+	supply NFTSupply,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathNFTCount, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+
+	var m struct {
+		Contract string      `json:"contract"`
+		Height   base.Height `json:"height"`
+		NFTCount uint64      `json:"nft_total_supply"`
+		Minted   uint64      `json:"minted"`
+		Active   uint64      `json:"active"`
+		Burned   uint64      `json:"burned"`
+	}
+
+	m.Contract = contract
+	m.Height = supply.Height
+	m.NFTCount = supply.Active()
+	m.Minted = supply.Minted
+	m.Active = supply.Active()
+	m.Burned = supply.Burned
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(m, currencydigest.NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("collection", currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTStats(w http.ResponseWriter, r *http.Request) {
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	top := parseStatsQuery(r.URL.Query().Get("top"), 10)
+	period := parseStatsQuery(r.URL.Query().Get("period"), 1000)
+	periods := parseStatsQuery(r.URL.Query().Get("periods"), 10)
+
+	switch {
+	case top < 1, period < 1, periods < 1:
+		currencydigest.HTTP2ProblemWithError(
+			w, errors.Errorf("invalid stats query; top, period and periods should be positive"), http.StatusBadRequest)
+
+		return
+	case top > maxLimit:
+		top = maxLimit
+	}
+
+	if periods > maxLimit {
+		periods = maxLimit
+	}
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path,
+		"top="+strconv.FormatInt(top, 10),
+		"period="+strconv.FormatInt(period, 10),
+		"periods="+strconv.FormatInt(periods, 10),
+	)
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTStatsInGroup(contract, top, period, periods)
+	}); err != nil {
+		hd.Log().Err(err).Str("contract", contract).Msg("failed to get nft collection stats")
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, hd.expireStats)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTStatsInGroup(contract string, top, period, periods int64) ([]byte, error) {
+	stats, err := NFTCollectionStats(hd.database, contract, top, period, periods)
+	if err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft collection stats by contract, %s", contract)
+	}
+
+	h, err := hd.combineURL(HandlerPathNFTStats, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(stats, currencydigest.NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("collection", currencydigest.NewHalLink(h, nil))
+
+	return hd.encoder.Marshal(hal)
+}
+
+// handleNFTHolders streams the holder snapshot of collection; the snapshot is
+// neither paginated nor cached, and an error after the first holding only
+// stops the stream.
+func (hd *Handlers) handleNFTHolders(w http.ResponseWriter, r *http.Request) {
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	height, err, status := hd.parseHistoryHeightQuery(r, contract)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	format := HolderSnapshotJSON
+	if s := currencydigest.ParseStringQuery(r.URL.Query().Get("format")); len(s) > 0 {
+		format = HolderSnapshotFormat(strings.ToLower(s))
+	}
+
+	if err := format.IsValid(nil); err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if _, err := NFTCollection(hd.database, contract, height); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.WriteHeader(http.StatusOK)
+
+	sw := NewHolderSnapshotWriter(w, format, contract, height)
+
+	if err := NFTHolderSnapshot(hd.database, contract, height, func(holding NFTHolding) (bool, error) {
+		return true, sw.Write(holding)
+	}); err != nil {
+		hd.Log().Err(err).Str("contract", contract).Msg("failed to stream holder snapshot")
+
+		// NOTE the status is already sent; the response is aborted without
+		// trailer, so the client finds the snapshot truncated.
+		panic(http.ErrAbortHandler)
+	}
+
+	if err := sw.Close(); err != nil {
+		hd.Log().Err(err).Str("contract", contract).Msg("failed to close holder snapshot")
+	}
+}
+
+func (hd *Handlers) buildNFTsHal(
+	contract string,
+	vas []currencydigest.Hal,
+	offset string,
+	reverse bool,
+	traits []TraitFilter,
+	height base.Height,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathNFTs, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range traits {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, stringTraitQuery([]string{t.Key + ":" + t.Value}))
+	}
+
+	if height > base.NilHeight {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, stringHeightQuery(height))
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("collection", currencydigest.NewHalLink(h, nil))
+
+	var nextoffset string
+
+	if len(vas) > 0 {
+		va := vas[len(vas)-1].Interface().(types.NFT)
+		nextoffset = strconv.FormatUint(va.ID(), 10)
+	}
+
+	if len(nextoffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextoffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink(
+		"reverse",
+		currencydigest.NewHalLink(
+			currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)),
+			nil,
+		),
+	)
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountNFTs(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+	contract := currencydigest.ParseStringQuery(r.URL.Query().Get("contract"))
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, stringContractQuery(contract),
+		currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
+
+	account, err, status := currencydigest.ParseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if len(offset) > 0 {
+		if _, _, err := ParseAccountNFTsOffset(offset); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleAccountNFTsInGroup(account, contract, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Str("account", account).Msg("failed to get nfts of account")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleAccountNFTsInGroup(
+	account, contract, offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("account-nfts")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	var nextoffset string
+	if err := NFTsByOwner(
+		hd.database, account, contract, offset, reverse, limit,
+		func(contract string, nft types.NFT, st base.State) (bool, error) {
+			hal, err := hd.buildNFTHal(contract, nft)
+			if err != nil {
+				return false, err
+			}
+
+			h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+			if err != nil {
+				return false, err
+			}
+			hal = hal.AddLink("collection", currencydigest.NewHalLink(h, nil))
+
+			vas = append(vas, hal)
+			nextoffset = contract + "," + strconv.FormatUint(nft.ID(), 10)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "nft tokens by account, %s", account)
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("nft tokens by account, %s", account)
+	}
+
+	i, err := hd.buildAccountNFTsHal(account, contract, vas, offset, nextoffset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildAccountNFTsHal(
+	account, contract string,
+	vas []currencydigest.Hal,
+	offset, nextoffset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathAccountNFTs, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contract) > 0 {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, stringContractQuery(contract))
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	if len(nextoffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextoffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink(
+		"reverse",
+		currencydigest.NewHalLink(
+			currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)),
+			nil,
+		),
+	)
+
+	return hal, nil
+}
+
+// creatorNFT is an nft with the share and sign status of a creator.
+type creatorNFT struct {
+	Contract string    `json:"contract"`
+	Share    uint      `json:"share"`
+	Signed   bool      `json:"signed"`
+	NFT      types.NFT `json:"nft"`
+}
+
+func (hd *Handlers) handleAccountCreated(w http.ResponseWriter, r *http.Request) {
+	hd.handleCreatorNFTs(w, r, HandlerPathAccountCreated, false)
+}
+
+func (hd *Handlers) handleAccountPendingSignatures(w http.ResponseWriter, r *http.Request) {
+	hd.handleCreatorNFTs(w, r, HandlerPathAccountPendingSigns, true)
+}
+
+func (hd *Handlers) handleCreatorNFTs(w http.ResponseWriter, r *http.Request, path string, pending bool) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+	contract := currencydigest.ParseStringQuery(r.URL.Query().Get("contract"))
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, stringContractQuery(contract),
+		currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
+
+	account, err, status := currencydigest.ParseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if len(offset) > 0 {
+		if _, _, err := ParseAccountNFTsOffset(offset); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleCreatorNFTsInGroup(path, account, contract, offset, pending, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Str("account", account).Msg("failed to get nfts of creator")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleCreatorNFTsInGroup(
+	path, account, contract, offset string,
+	pending, reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("creator-nfts")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	var nextoffset string
+	if err := NFTsByCreator(
+		hd.database, account, contract, offset, pending, reverse, limit,
+		func(contract string, nft types.NFT, st base.State) (bool, error) {
+			var share uint
+			var signed bool
+			for _, sgn := range nft.Creators().Signers() {
+				if sgn.Address().String() == account {
+					share, signed = sgn.Share(), sgn.Signed()
+
+					break
+				}
+			}
+
+			h, err := hd.combineURL(
+				HandlerPathNFT, "contract", contract, "nft_idx", strconv.FormatUint(nft.ID(), 10))
+			if err != nil {
+				return false, err
+			}
+
+			vas = append(vas, currencydigest.NewBaseHal(creatorNFT{
+				Contract: contract,
+				Share:    share,
+				Signed:   signed,
+				NFT:      nft,
+			}, currencydigest.NewHalLink(h, nil)))
+			nextoffset = contract + "," + strconv.FormatUint(nft.ID(), 10)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "nft tokens by creator, %s", account)
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("nft tokens by creator, %s", account)
+	}
+
+	i, err := hd.buildCreatorNFTsHal(path, account, contract, vas, offset, nextoffset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildCreatorNFTsHal(
+	path, account, contract string,
+	vas []currencydigest.Hal,
+	offset, nextoffset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(path, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contract) > 0 {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, stringContractQuery(contract))
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	if len(nextoffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextoffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink(
+		"reverse",
+		currencydigest.NewHalLink(
+			currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)),
+			nil,
+		),
+	)
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountAllApprovers(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+	contract := currencydigest.ParseStringQuery(r.URL.Query().Get("contract"))
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, stringContractQuery(contract),
+		currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
+
+	account, err, status := currencydigest.ParseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if len(offset) > 0 {
+		if _, _, err := ParseAllApproversOffset(offset); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleAccountAllApproversInGroup(account, contract, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Str("account", account).Msg("failed to get all approvers of account")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleAccountAllApproversInGroup(
+	account, contract, offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("account-allapprovers")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	var nextoffset string
+	if err := NFTAllApprovers(
+		hd.database, account, contract, offset, reverse, limit,
+		func(approver NFTAllApprover) (bool, error) {
+			h, err := hd.combineURL(
+				HandlerPathNFTAllApproved, "contract", approver.Contract, "address", approver.Owner)
+			if err != nil {
+				return false, err
+			}
+
+			vas = append(vas, currencydigest.NewBaseHal(approver, currencydigest.NewHalLink(h, nil)))
+			nextoffset = approver.Contract + "," + approver.Owner
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "all approvers of account, %s", account)
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("all approvers of account, %s", account)
+	}
+
+	i, err := hd.buildAccountAllApproversHal(account, contract, vas, offset, nextoffset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildAccountAllApproversHal(
+	account, contract string,
+	vas []currencydigest.Hal,
+	offset, nextoffset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathAccountAllApprovers, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contract) > 0 {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, stringContractQuery(contract))
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	if len(nextoffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextoffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink(
+		"reverse",
+		currencydigest.NewHalLink(
+			currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)),
+			nil,
+		),
+	)
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountApprovedNFTs(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+	contract := currencydigest.ParseStringQuery(r.URL.Query().Get("contract"))
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, stringContractQuery(contract),
+		currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
+
+	account, err, status := currencydigest.ParseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if len(offset) > 0 {
+		if _, _, err := ParseAccountNFTsOffset(offset); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleAccountApprovedNFTsInGroup(account, contract, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Str("account", account).Msg("failed to get approved nfts of account")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+func (hd *Handlers) handleAccountApprovedNFTsInGroup(
+	account, contract, offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("account-approved-nfts")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	var nextoffset string
+	if err := NFTsByApproved(
+		hd.database, account, contract, offset, reverse, limit,
+		func(contract string, nft types.NFT, st base.State) (bool, error) {
+			hal, err := hd.buildNFTHal(contract, nft)
+			if err != nil {
+				return false, err
+			}
+
+			h, err := hd.combineURL(HandlerPathNFTCollection, "contract", contract)
+			if err != nil {
+				return false, err
+			}
+			hal = hal.AddLink("collection", currencydigest.NewHalLink(h, nil))
+
+			vas = append(vas, hal)
+			nextoffset = contract + "," + strconv.FormatUint(nft.ID(), 10)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "nft tokens approved for account, %s", account)
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("nft tokens approved for account, %s", account)
+	}
+
+	i, err := hd.buildAccountApprovedNFTsHal(account, contract, vas, offset, nextoffset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildAccountApprovedNFTsHal(
+	account, contract string,
+	vas []currencydigest.Hal,
+	offset, nextoffset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathAccountApprovedNFTs, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contract) > 0 {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, stringContractQuery(contract))
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	if len(nextoffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextoffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink(
+		"reverse",
+		currencydigest.NewHalLink(
+			currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)),
+			nil,
+		),
+	)
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTOperators(w http.ResponseWriter, r *http.Request) {
+	height, err := parseHeightQuery(r)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := currencydigest.CacheKey(r.URL.Path, stringHeightQuery(height))
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	account, err, status := currencydigest.ParseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTOperatorsInGroup(contract, account, height)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTOperatorsInGroup(contract, account string, height base.Height) (interface{}, error) {
+	switch operators, err := NFTOperators(hd.database, contract, account, height); {
+	case err != nil:
+		return nil, err
+	default:
+		hal, err := hd.buildNFTOperatorsHal(contract, account, *operators)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildNFTOperatorsHal(contract, account string, operators types.AllApprovedBook) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathNFTAllApproved, "contract", contract, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(operators, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTPermitted(w http.ResponseWriter, r *http.Request) {
+	cachekey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	account, err, status := currencydigest.ParseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTPermittedInGroup(contract, account)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTPermittedInGroup(contract, account string) ([]byte, error) {
+	if _, err := NFTCollection(hd.database, contract, base.NilHeight); err != nil {
+		return nil, err
+	}
+
+	mode, err := NFTRestrictionMode(hd.database, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	var listed bool
+	if mode != types.RestrictionNone {
+		listed, err = NFTRestriction(hd.database, contract, account)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hal, err := hd.buildNFTPermittedHal(contract, account, mode, listed)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(hal)
+}
+
+func (hd *Handlers) buildNFTPermittedHal(
+	contract, account string,
+	mode types.RestrictionMode,
+	listed bool,
+) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathNFTPermitted, "contract", contract, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	var m struct {
+		Contract  string                `json:"contract"`
+		Account   string                `json:"account"`
+		Mode      types.RestrictionMode `json:"mode"`
+		Listed    bool                  `json:"listed"`
+		Permitted bool                  `json:"permitted"`
+	}
+
+	m.Contract = contract
+	m.Account = account
+	m.Mode = mode
+	m.Listed = listed
+	m.Permitted = mode.Permits(listed)
+
+	hal := currencydigest.NewBaseHal(m, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTCouncil(w http.ResponseWriter, r *http.Request) {
+	cachekey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTCouncilInGroup(contract)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTCouncilInGroup(contract string) ([]byte, error) {
+	council, err := NFTCouncil(hd.database, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(HandlerPathNFTCouncil, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(*council, currencydigest.NewHalLink(h, nil))
+
+	return hd.encoder.Marshal(hal)
+}
+
+func (hd *Handlers) handleNFTProposal(w http.ResponseWriter, r *http.Request) {
+	cachekey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	id, err, status := currencydigest.ParseRequest(w, r, "proposal_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTProposalInGroup(contract, id)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTProposalInGroup(contract, id string) ([]byte, error) {
+	proposal, err := NFTProposal(hd.database, contract, id)
+	if err != nil {
+		return nil, err
+	}
+
+	hal, err := hd.buildNFTProposalHal(contract, *proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(hal)
+}
+
+// buildNFTProposalHal shows the status of proposal at the last digested block,
+// so an expired pending proposal is shown as rejected.
+func (hd *Handlers) buildNFTProposalHal(contract string, proposal types.Proposal) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathNFTProposal, "contract", contract, "proposal_id", proposal.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	var m struct {
+		Proposal types.Proposal       `json:"proposal"`
+		Status   types.ProposalStatus `json:"status"`
+	}
+
+	m.Proposal = proposal
+	m.Status = proposal.StatusAt(hd.database.LastBlock())
+
+	hal := currencydigest.NewBaseHal(m, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleNFTProposals(w http.ResponseWriter, r *http.Request) {
+	cachekey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleNFTProposalsInGroup(contract)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleNFTProposalsInGroup(contract string) ([]byte, error) {
+	var vas []currencydigest.Hal
+	if err := NFTProposals(
+		hd.database, contract,
+		func(proposal types.Proposal) (bool, error) {
+			hal, err := hd.buildNFTProposalHal(contract, proposal)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "nft proposals by contract, %s", contract)
+	} else if len(vas) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("nft proposals by contract, %s", contract)
+	}
+
+	h, err := hd.combineURL(HandlerPathNFTProposals, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(h, nil))
+
+	return hd.encoder.Marshal(hal)
+}
+
+func stringTraitQuery(qs []string) string {
+	if len(qs) < 1 {
+		return ""
+	}
+
+	l := make([]string, len(qs))
+	for i, q := range qs {
+		l[i] = "trait=" + url.QueryEscape(q)
+	}
+
+	return strings.Join(l, "&")
+}
+
+func stringContractQuery(contract string) string {
+	if len(contract) < 1 {
+		return ""
+	}
+
+	return "contract=" + url.QueryEscape(contract)
+}
+
+func stringCollectionsFilterQuery(filter CollectionsFilter) string {
+	q := url.Values{}
+	if len(filter.Creator) > 0 {
+		q.Set("creator", filter.Creator)
+	}
+	if filter.Active != nil {
+		q.Set("active", strconv.FormatBool(*filter.Active))
+	}
+	if len(filter.NamePrefix) > 0 {
+		q.Set("name", filter.NamePrefix)
+	}
+
+	return q.Encode()
+}
+
+// parseStatsQuery parses the integer query of stats; d is returned when s is empty
+// and -1 when s is invalid.
+func parseStatsQuery(s string, d int64) int64 {
+	if len(s) < 1 {
+		return d
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return n
+}
+
+func stringHeightQuery(height base.Height) string {
+	if height <= base.NilHeight {
+		return ""
+	}
+
+	return "height=" + height.String()
+}
+
+// parseHeightQuery parses the height query; base.NilHeight is returned when it
+// is empty, which means the latest height.
+func parseHeightQuery(r *http.Request) (base.Height, error) {
+	s := currencydigest.ParseStringQuery(r.URL.Query().Get("height"))
+	if len(s) < 1 {
+		return base.NilHeight, nil
+	}
+
+	height, err := base.ParseHeightString(s)
+	if err != nil {
+		return base.NilHeight, errors.Errorf("invalid height query, %q", s)
+	}
+
+	return height, nil
+}
+
+// parseHistoryHeightQuery parses the height query for the nfts of contract
+// looked up in nft history; the height before the history of contract keeps
+// every version of its nfts is rejected, because some nfts as of that height
+// are not known.
+func (hd *Handlers) parseHistoryHeightQuery(r *http.Request, contract string) (base.Height, error, int) {
+	height, err := parseHeightQuery(r)
+	if err != nil {
+		return base.NilHeight, err, http.StatusBadRequest
+	}
+
+	if height <= base.NilHeight {
+		return height, nil, http.StatusOK
+	}
+
+	switch complete, err := NFTHistoryCompleteHeight(hd.database, contract); {
+	case err != nil:
+		return base.NilHeight, err, http.StatusInternalServerError
+	case height < complete:
+		return base.NilHeight, errors.Errorf(
+			"invalid height query, %v; nft history of contract account %v is complete from height %v",
+			height, contract, complete), http.StatusBadRequest
+	}
+
+	return height, nil, http.StatusOK
 }
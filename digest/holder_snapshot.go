@@ -0,0 +1,384 @@
+package digest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// ErrHolderSnapshotTruncated is returned when the snapshot ends without the
+// trailer written by HolderSnapshotWriter.Close or the trailer does not match
+// the holdings; the snapshot is streamed after the response status, so a
+// failure in the middle can only be told by the trailer.
+var ErrHolderSnapshotTruncated = util.NewIDError("truncated holder snapshot")
+
+// holderSnapshotTrailer is the key of the number of holders in the trailer.
+const holderSnapshotTrailer = "total_holders"
+
+type HolderSnapshotFormat string
+
+const (
+	HolderSnapshotJSON   HolderSnapshotFormat = "json"
+	HolderSnapshotCSV    HolderSnapshotFormat = "csv"
+	HolderSnapshotNDJSON HolderSnapshotFormat = "ndjson"
+)
+
+func (f HolderSnapshotFormat) IsValid([]byte) error {
+	switch f {
+	case HolderSnapshotJSON, HolderSnapshotCSV, HolderSnapshotNDJSON:
+		return nil
+	default:
+		return errors.Errorf("unknown holder snapshot format, %q", f)
+	}
+}
+
+func (f HolderSnapshotFormat) ContentType() string {
+	switch f {
+	case HolderSnapshotCSV:
+		return "text/csv; charset=utf-8"
+	case HolderSnapshotNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "application/json"
+	}
+}
+
+// HolderSnapshotWriter writes the holdings of snapshot one by one, so the
+// snapshot of large collection is not buffered.
+type HolderSnapshotWriter struct {
+	w        *bufio.Writer
+	csv      *csv.Writer
+	format   HolderSnapshotFormat
+	contract string
+	height   base.Height
+	started  bool
+	written  int
+}
+
+func NewHolderSnapshotWriter(
+	w io.Writer, format HolderSnapshotFormat, contract string, height base.Height,
+) *HolderSnapshotWriter {
+	bw := bufio.NewWriter(w)
+
+	sw := &HolderSnapshotWriter{
+		w:        bw,
+		format:   format,
+		contract: contract,
+		height:   height,
+	}
+
+	if format == HolderSnapshotCSV {
+		sw.csv = csv.NewWriter(bw)
+	}
+
+	return sw
+}
+
+func (sw *HolderSnapshotWriter) Write(holding NFTHolding) error {
+	if err := sw.writeHeader(); err != nil {
+		return err
+	}
+
+	switch sw.format {
+	case HolderSnapshotCSV:
+		idxes := make([]string, len(holding.NFTs))
+		for i := range holding.NFTs {
+			idxes[i] = strconv.FormatUint(holding.NFTs[i], 10)
+		}
+
+		if err := sw.csv.Write([]string{
+			holding.Address, strconv.FormatInt(holding.Count, 10), strings.Join(idxes, ";"),
+		}); err != nil {
+			return err
+		}
+	case HolderSnapshotNDJSON:
+		if err := sw.writeJSON(holding); err != nil {
+			return err
+		}
+
+		if err := sw.w.WriteByte('\n'); err != nil {
+			return err
+		}
+	default:
+		if sw.written > 0 {
+			if err := sw.w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+
+		if err := sw.writeJSON(holding); err != nil {
+			return err
+		}
+	}
+
+	sw.written++
+
+	return nil
+}
+
+// Close finishes the snapshot with the trailer of the number of holders; it
+// should be called even when nothing is written, and should not be called
+// when the holdings are not all written, so the snapshot is found truncated.
+func (sw *HolderSnapshotWriter) Close() error {
+	if err := sw.writeHeader(); err != nil {
+		return err
+	}
+
+	total := strconv.Itoa(sw.written)
+
+	switch sw.format {
+	case HolderSnapshotCSV:
+		if err := sw.csv.Write([]string{"#" + holderSnapshotTrailer, total, ""}); err != nil {
+			return err
+		}
+
+		sw.csv.Flush()
+		if err := sw.csv.Error(); err != nil {
+			return err
+		}
+	case HolderSnapshotNDJSON:
+		if _, err := sw.w.WriteString(`{"` + holderSnapshotTrailer + `":` + total + "}\n"); err != nil {
+			return err
+		}
+	default:
+		if _, err := sw.w.WriteString(`],"` + holderSnapshotTrailer + `":` + total + "}"); err != nil {
+			return err
+		}
+	}
+
+	return sw.w.Flush()
+}
+
+func (sw *HolderSnapshotWriter) writeHeader() error {
+	if sw.started {
+		return nil
+	}
+
+	sw.started = true
+
+	switch sw.format {
+	case HolderSnapshotCSV:
+		return sw.csv.Write([]string{"address", "count", "nft_idxes"})
+	case HolderSnapshotJSON:
+		contract, err := json.Marshal(sw.contract)
+		if err != nil {
+			return err
+		}
+
+		header := `{"contract":` + string(contract)
+		if sw.height > base.NilHeight {
+			header += `,"height":` + sw.height.String()
+		}
+
+		_, err = sw.w.WriteString(header + `,"holders":[`)
+
+		return err
+	default:
+		return nil
+	}
+}
+
+func (sw *HolderSnapshotWriter) writeJSON(holding NFTHolding) error {
+	b, err := json.Marshal(holding)
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.w.Write(b)
+
+	return err
+}
+
+// CheckHolderSnapshot reads the snapshot of format from r to the end and checks
+// its trailer against the holdings; ErrHolderSnapshotTruncated is returned
+// when the trailer is missing or does not match. Only one holding is kept in
+// memory at a time.
+func CheckHolderSnapshot(r io.Reader, format HolderSnapshotFormat) error {
+	var holders int
+	var err error
+
+	switch format {
+	case HolderSnapshotCSV:
+		holders, err = checkCSVHolderSnapshot(r)
+	case HolderSnapshotNDJSON:
+		holders, err = checkNDJSONHolderSnapshot(r)
+	case HolderSnapshotJSON:
+		holders, err = checkJSONHolderSnapshot(r)
+	default:
+		return format.IsValid(nil)
+	}
+
+	if err != nil {
+		return ErrHolderSnapshotTruncated.Wrap(err)
+	}
+
+	if holders < 0 {
+		return ErrHolderSnapshotTruncated.Errorf("no trailer")
+	}
+
+	return nil
+}
+
+// checkCSVHolderSnapshot returns -1 when the last record is not the trailer.
+func checkCSVHolderSnapshot(r io.Reader) (int, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 3
+	cr.ReuseRecord = true
+
+	if _, err := cr.Read(); err != nil {
+		return -1, errors.Wrap(err, "header")
+	}
+
+	var n int
+	total := -1
+
+	for {
+		record, err := cr.Read()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return total, nil
+		case err != nil:
+			return -1, err
+		case total >= 0:
+			return -1, errors.Errorf("record after trailer")
+		case record[0] == "#"+holderSnapshotTrailer:
+			if total, err = checkHolderSnapshotTotal(record[1], n); err != nil {
+				return -1, err
+			}
+		default:
+			n++
+		}
+	}
+}
+
+// checkNDJSONHolderSnapshot returns -1 when the last line is not the trailer.
+func checkNDJSONHolderSnapshot(r io.Reader) (int, error) {
+	br := bufio.NewReader(r)
+
+	var n int
+	total := -1
+
+	for {
+		line, err := br.ReadBytes('\n')
+
+		switch {
+		case errors.Is(err, io.EOF):
+			if len(bytes.TrimSpace(line)) > 0 {
+				return -1, errors.Errorf("line without newline")
+			}
+
+			return total, nil
+		case err != nil:
+			return -1, err
+		case total >= 0:
+			return -1, errors.Errorf("line after trailer")
+		}
+
+		var u map[string]json.RawMessage
+		if err := json.Unmarshal(line, &u); err != nil {
+			return -1, err
+		}
+
+		if b, found := u[holderSnapshotTrailer]; found {
+			if total, err = checkHolderSnapshotTotal(string(b), n); err != nil {
+				return -1, err
+			}
+
+			continue
+		}
+
+		n++
+	}
+}
+
+// checkJSONHolderSnapshot returns -1 when the object has no trailer.
+func checkJSONHolderSnapshot(r io.Reader) (int, error) {
+	dec := json.NewDecoder(r)
+
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return -1, err
+	}
+
+	var n int
+	total := -1
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return -1, err
+		}
+
+		switch t {
+		case "holders":
+			if err := expectJSONDelim(dec, '['); err != nil {
+				return -1, err
+			}
+
+			for dec.More() {
+				var holding json.RawMessage
+				if err := dec.Decode(&holding); err != nil {
+					return -1, err
+				}
+
+				n++
+			}
+
+			if err := expectJSONDelim(dec, ']'); err != nil {
+				return -1, err
+			}
+		case holderSnapshotTrailer:
+			var b json.Number
+			if err := dec.Decode(&b); err != nil {
+				return -1, err
+			}
+
+			if total, err = checkHolderSnapshotTotal(b.String(), n); err != nil {
+				return -1, err
+			}
+		default:
+			var v json.RawMessage
+			if err := dec.Decode(&v); err != nil {
+				return -1, err
+			}
+		}
+	}
+
+	if err := expectJSONDelim(dec, '}'); err != nil {
+		return -1, err
+	}
+
+	return total, nil
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return err
+	case t != delim:
+		return errors.Errorf("expected %v, not %v", delim, t)
+	default:
+		return nil
+	}
+}
+
+func checkHolderSnapshotTotal(s string, holders int) (int, error) {
+	total, err := strconv.Atoi(s)
+
+	switch {
+	case err != nil:
+		return -1, errors.Wrap(err, "trailer")
+	case total != holders:
+		return -1, errors.Errorf("trailer has %d holders, but %d holders written", total, holders)
+	default:
+		return total, nil
+	}
+}
@@ -0,0 +1,114 @@
+package digest
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+var testHolderSnapshotFormats = []HolderSnapshotFormat{
+	HolderSnapshotJSON, HolderSnapshotCSV, HolderSnapshotNDJSON,
+}
+
+func writeTestHolderSnapshot(t *testing.T, format HolderSnapshotFormat, holdings []NFTHolding, closed bool) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	sw := NewHolderSnapshotWriter(&buf, format, "contract", base.Height(33))
+	for i := range holdings {
+		if err := sw.Write(holdings[i]); err != nil {
+			t.Fatalf("failed to write holding: %v", err)
+		}
+	}
+
+	if closed {
+		if err := sw.Close(); err != nil {
+			t.Fatalf("failed to close snapshot: %v", err)
+		}
+	} else {
+		if sw.csv != nil {
+			sw.csv.Flush()
+		}
+
+		if err := sw.w.Flush(); err != nil {
+			t.Fatalf("failed to flush snapshot: %v", err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func testHoldings() []NFTHolding {
+	return []NFTHolding{
+		{Address: "a0", Count: 2, NFTs: []uint64{0, 3}},
+		{Address: "a1", Count: 1, NFTs: []uint64{1}},
+		{Address: "a2", Count: 3, NFTs: []uint64{2, 4, 5}},
+	}
+}
+
+func TestCheckHolderSnapshot(t *testing.T) {
+	for _, format := range testHolderSnapshotFormats {
+		for _, holdings := range [][]NFTHolding{nil, testHoldings()} {
+			b := writeTestHolderSnapshot(t, format, holdings, true)
+
+			if err := CheckHolderSnapshot(bytes.NewReader(b), format); err != nil {
+				t.Errorf("%s with %d holders: unexpected error, %v\n%s", format, len(holdings), err, b)
+			}
+		}
+	}
+}
+
+func TestCheckHolderSnapshotNotClosed(t *testing.T) {
+	for _, format := range testHolderSnapshotFormats {
+		b := writeTestHolderSnapshot(t, format, testHoldings(), false)
+
+		err := CheckHolderSnapshot(bytes.NewReader(b), format)
+		if !errors.Is(err, ErrHolderSnapshotTruncated) {
+			t.Errorf("%s: expected truncated error, not %v\n%s", format, err, b)
+		}
+	}
+}
+
+func TestCheckHolderSnapshotCut(t *testing.T) {
+	for _, format := range testHolderSnapshotFormats {
+		b := writeTestHolderSnapshot(t, format, testHoldings(), true)
+
+		// NOTE every prefix of the complete snapshot should be found truncated,
+		// except the one without the last newline.
+		for i := 0; i < len(b)-1; i++ {
+			err := CheckHolderSnapshot(bytes.NewReader(b[:i]), format)
+			if !errors.Is(err, ErrHolderSnapshotTruncated) {
+				t.Errorf("%s cut at %d: expected truncated error, not %v\n%s", format, i, err, b[:i])
+			}
+		}
+	}
+}
+
+func TestCheckHolderSnapshotWrongTrailer(t *testing.T) {
+	for _, format := range testHolderSnapshotFormats {
+		b := writeTestHolderSnapshot(t, format, testHoldings(), true)
+
+		var s string
+		switch format {
+		case HolderSnapshotCSV:
+			s = strings.Replace(string(b), "a1,1,1\n", "", 1)
+		case HolderSnapshotNDJSON:
+			s = strings.Replace(string(b), `{"address":"a1","count":1,"nft_idxes":[1]}`+"\n", "", 1)
+		default:
+			s = strings.Replace(string(b), `,{"address":"a1","count":1,"nft_idxes":[1]}`, "", 1)
+		}
+
+		if s == string(b) {
+			t.Fatalf("%s: holding not removed\n%s", format, b)
+		}
+
+		err := CheckHolderSnapshot(strings.NewReader(s), format)
+		if !errors.Is(err, ErrHolderSnapshotTruncated) {
+			t.Errorf("%s: expected truncated error, not %v\n%s", format, err, s)
+		}
+	}
+}
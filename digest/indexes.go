@@ -0,0 +1,108 @@
+package digest
+
+import (
+	"context"
+
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var nftIndexPrefix = "mitum_nft_"
+
+// nftIndexes are the indexes of nft collections, which are not covered by the
+// indexes of currency collections.
+var nftIndexes = map[string][]mongo.IndexModel{
+	defaultColNameNFTCollection: {
+		{
+			Keys: bson.D{
+				{"contract", 1},
+				{"height", -1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nftcollection_contract_height"),
+		},
+	},
+	defaultColNameNFT: {
+		{
+			Keys: bson.D{
+				{"owner", 1},
+				{"contract", 1},
+				{"nft_idx", 1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nft_owner_contract_idx"),
+		},
+		{
+			Keys: bson.D{
+				{"approved", 1},
+				{"contract", 1},
+				{"nft_idx", 1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nft_approved_contract_idx"),
+		},
+		{
+			Keys: bson.D{
+				{"creators.address", 1},
+				{"contract", 1},
+				{"nft_idx", 1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nft_creators_contract_idx"),
+		},
+	},
+	defaultColNameNFTLastIndex: {
+		{
+			Keys: bson.D{
+				{"contract", 1},
+				{"height", -1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nftlastindex_contract_height"),
+		},
+	},
+	defaultColNameNFTOperator: {
+		{
+			Keys: bson.D{
+				{"operators", 1},
+				{"contract", 1},
+				{"address", 1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nftoperator_operators_contract_address"),
+		},
+		{
+			Keys: bson.D{
+				{"contract", 1},
+				{"address", 1},
+				{"height", -1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nftoperator_contract_address_height"),
+		},
+	},
+	defaultColNameNFTHistory: {
+		{
+			Keys: bson.D{
+				{"contract", 1},
+				{"nft_idx", 1},
+				{"height", 1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nfthistory_contract_idx_height"),
+		},
+		{
+			Keys: bson.D{
+				{"height", 1},
+			},
+			Options: options.Index().SetName(nftIndexPrefix + "nfthistory_height"),
+		},
+	},
+}
+
+// CreateNFTIndexes creates the indexes of nft collections in st; an existing
+// index of the same name and keys is kept.
+func CreateNFTIndexes(ctx context.Context, st *currencydigest.Database) error {
+	for col, models := range nftIndexes {
+		if _, err := st.MongoClient().Collection(col).Indexes().CreateMany(ctx, models); err != nil {
+			return errors.Wrapf(err, "failed to create indexes of %s", col)
+		}
+	}
+
+	return nil
+}
@@ -140,7 +140,7 @@ func (ipp *SignItemProcessor) Process(
 		return nil, errors.Errorf("failed to set signer for signers, %v: %w", signer, err)
 	}
 
-	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), *sns)
+	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), *sns, nv.Attributes(), nv.Provenance())
 
 	if err := n.IsValid(nil); err != nil {
 		return nil, errors.Errorf("invalid nft, %v: %w", n.ID(), err)
@@ -196,6 +196,8 @@ func NewSignProcessor() currencytypes.GetNewProcessor {
 func (opp *SignProcessor) PreProcess(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
 ) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(AddSignatureFact)
 	if !ok {
 		return ctx, base.NewBaseOperationProcessReasonError(
@@ -255,6 +257,8 @@ func (opp *SignProcessor) Process( // nolint:dupl
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
 	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
 ) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Sign")
 
 	fact, ok := op.Fact().(AddSignatureFact)
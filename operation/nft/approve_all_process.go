@@ -142,6 +142,8 @@ func NewDelegateProcessor() currencytypes.GetNewProcessor {
 func (opp *DelegateProcessor) PreProcess(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
 ) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(ApproveAllFact)
 	if !ok {
 		return ctx, mitumbase.NewBaseOperationProcessReasonError(
@@ -238,6 +240,8 @@ func (opp *DelegateProcessor) Process(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
 	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
 ) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Delegate")
 
 	fact, ok := op.Fact().(ApproveAllFact)
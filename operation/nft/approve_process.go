@@ -171,7 +171,7 @@ func (ipp *ApproveItemProcessor) Process(
 		return nil, util.ErrNotFound.Errorf("nft value, %v: %v", nid, err)
 	}
 
-	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), ipp.item.Approved(), nv.Creators())
+	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), ipp.item.Approved(), nv.Creators(), nv.Attributes(), nv.Provenance())
 	if err := n.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -225,6 +225,8 @@ func NewApproveProcessor() currencytypes.GetNewProcessor {
 func (opp *ApproveProcessor) PreProcess(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
 ) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(ApproveFact)
 	if !ok {
 		return ctx, mitumbase.NewBaseOperationProcessReasonError(
@@ -284,6 +286,8 @@ func (opp *ApproveProcessor) Process(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
 	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
 ) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Approve")
 
 	fact, _ := op.Fact().(ApproveFact)
@@ -0,0 +1,126 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ApproveProposalFactHint = hint.MustNewHint("mitum-nft-approve-proposal-operation-fact-v0.0.1")
+	ApproveProposalHint     = hint.MustNewHint("mitum-nft-approve-proposal-operation-v0.0.1")
+)
+
+type ApproveProposalFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	proposal string
+	currency currencytypes.CurrencyID
+}
+
+func NewApproveProposalFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	proposal string,
+	currency currencytypes.CurrencyID,
+) ApproveProposalFact {
+	bf := mitumbase.NewBaseFact(ApproveProposalFactHint, token)
+
+	fact := ApproveProposalFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		proposal: proposal,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveProposalFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if len(fact.proposal) < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty proposal id")))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ApproveProposalFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveProposalFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveProposalFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposal),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ApproveProposalFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveProposalFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact ApproveProposalFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact ApproveProposalFact) Proposal() string {
+	return fact.proposal
+}
+
+func (fact ApproveProposalFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ApproveProposalFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type ApproveProposal struct {
+	common.BaseOperation
+}
+
+func NewApproveProposal(fact ApproveProposalFact) (ApproveProposal, error) {
+	return ApproveProposal{BaseOperation: common.NewBaseOperation(ApproveProposalHint, fact)}, nil
+}
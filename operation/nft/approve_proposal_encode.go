@@ -0,0 +1,32 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ApproveProposalFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	pr string,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.proposal = pr
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
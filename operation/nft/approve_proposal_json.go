@@ -0,0 +1,71 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ApproveProposalFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender   mitumbase.Address        `json:"sender"`
+	Contract mitumbase.Address        `json:"contract"`
+	Proposal string                   `json:"proposal"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact ApproveProposalFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveProposalFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Proposal:              fact.proposal,
+		Currency:              fact.currency,
+	})
+}
+
+type ApproveProposalFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	Proposal string `json:"proposal"`
+	Currency string `json:"currency"`
+}
+
+func (fact *ApproveProposalFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ApproveProposalFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Proposal, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ApproveProposalMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ApproveProposal) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveProposalMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ApproveProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,354 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveProposalProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveProposalProcessor)
+	},
+}
+
+func (ApproveProposal) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ApproveProposalProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewApproveProposalProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new ApproveProposalProcessor")
+
+		nopp := approveProposalProcessorPool.Get()
+		opp, ok := nopp.(*ApproveProposalProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ApproveProposalProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveProposalProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(ApproveProposalFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ApproveProposalFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v", cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	_, _, aErr, cErr := state.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", cErr)), nil
+	}
+
+	st, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft collection state for contract account %v", fact.Contract())), nil
+
+	}
+
+	design, err := statenft.StateCollectionValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft collection state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("collection in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	cst, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CouncilKey), "council", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("council for contract account %v", fact.Contract())), nil
+	}
+
+	council, err := statenft.StateCouncilValue(cst)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateInvalid).
+				Errorf("council for contract account %v", fact.Contract())), nil
+	}
+
+	if !council.IsMember(fact.Sender()) {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not council member of contract account %v", fact.Sender(), fact.Contract())), nil
+	}
+
+	pst, err := state.ExistsState(statenft.StateKeyProposal(fact.Contract(), fact.Proposal()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("proposal %v for contract account %v", fact.Proposal(), fact.Contract())), nil
+	}
+
+	proposal, err := statenft.StateProposalValue(pst)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateInvalid).
+				Errorf("proposal %v for contract account %v", fact.Proposal(), fact.Contract())), nil
+	}
+
+	if status := proposal.StatusAt(opp.Height()); status != types.ProposalPending {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("proposal %v for contract account %v is not pending, %v", fact.Proposal(), fact.Contract(), status)), nil
+	}
+
+	if proposal.IsApprovedBy(fact.Sender()) {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("sender %v already approved proposal %v", fact.Sender(), fact.Proposal())), nil
+	}
+
+	if uint(len(proposal.Approvals())+1) >= council.Threshold() && proposal.Action() == nil {
+		policy, ok := design.Policy().(types.CollectionPolicy)
+		if !ok {
+			return nil, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+					Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())), nil
+		}
+
+		if policy.Name().Normalize() != proposal.Policy().Name().Normalize() {
+			if err := checkCollectionName(proposal.Policy().Name(), fact.Contract(), getStateFunc); err != nil {
+				return ctx, base.NewBaseOperationProcessReasonError(
+					common.ErrMPreProcess.
+						Errorf("%v", err)), nil
+			}
+
+			nctx, err := checkCollectionNameInBlock(ctx, proposal.Policy().Name(), fact.Contract())
+			if err != nil {
+				return ctx, base.NewBaseOperationProcessReasonError(
+					common.ErrMPreProcess.
+						Errorf("%v", err)), nil
+			}
+			ctx = nctx
+		}
+	}
+
+	nctx, err := checkProposalApprovedInBlock(ctx, pst.Key())
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("proposal %v for contract account %v: %v", fact.Proposal(), fact.Contract(), err)), nil
+	}
+
+	return nctx, nil, nil
+}
+
+// approvedProposalsContextKey keeps the proposals approved by the operations
+// preprocessed before in the same block.
+type approvedProposalsContextKey struct{}
+
+// checkProposalApprovedInBlock checks that the proposal of key is not approved
+// yet in the same block; the approvals are rebuilt from the state before the
+// block, so the second approval in a block would overwrite the first one. It
+// returns the context which carries the approved proposals.
+func checkProposalApprovedInBlock(ctx context.Context, key string) (context.Context, error) {
+	prev, _ := ctx.Value(approvedProposalsContextKey{}).(map[string]struct{})
+	if _, found := prev[key]; found {
+		return ctx, errors.Errorf("already approved in this block, approve it in the next block")
+	}
+
+	approved := make(map[string]struct{}, len(prev)+1)
+	for k := range prev {
+		approved[k] = struct{}{}
+	}
+	approved[key] = struct{}{}
+
+	return context.WithValue(ctx, approvedProposalsContextKey{}, approved), nil
+}
+
+func (opp *ApproveProposalProcessor) Process(
+	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process ApproveProposal")
+	fact, ok := op.Fact().(ApproveProposalFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected ApproveProposalFact, not %T", op.Fact())
+	}
+
+	cst, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CouncilKey), "council", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("council not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	council, err := statenft.StateCouncilValue(cst)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("council value not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	pst, err := state.ExistsState(statenft.StateKeyProposal(fact.Contract(), fact.Proposal()), "proposal", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("proposal not found, %v: %w", fact.Proposal(), err), nil
+	}
+
+	proposal, err := statenft.StateProposalValue(pst)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("proposal value not found, %v: %w", fact.Proposal(), err), nil
+	}
+
+	approvals := make([]mitumbase.Address, len(proposal.Approvals()), len(proposal.Approvals())+1)
+	copy(approvals, proposal.Approvals())
+	approvals = append(approvals, fact.Sender())
+
+	var sts []mitumbase.StateMergeValue
+	status := types.ProposalPending
+	switch {
+	case uint(len(approvals)) < council.Threshold():
+	case proposal.Action() != nil:
+		status = types.ProposalApplied
+
+		asts, err := proposalActionStates(fact.Contract(), *proposal.Action(), getStateFunc)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("failed to apply proposal action, %v: %w", proposal.Action().Kind(), err), nil
+		}
+		sts = append(sts, asts...)
+	default:
+		status = types.ProposalApplied
+
+		st, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CollectionKey), "design", getStateFunc)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("collection design not found, %v: %w", fact.Contract(), err), nil
+		}
+
+		design, err := statenft.StateCollectionValue(st)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("collection design value not found, %v: %w", fact.Contract(), err), nil
+		}
+
+		for _, white := range proposal.Policy().Whitelist() {
+			smv, err := state.CreateNotExistAccount(white, getStateFunc)
+			if err != nil {
+				return nil, mitumbase.NewBaseOperationProcessReasonError("%w", err), nil
+			} else if smv != nil {
+				sts = append(sts, smv)
+			}
+		}
+
+		policy, ok := design.Policy().(types.CollectionPolicy)
+		if !ok {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("expected %T, not %T", types.CollectionPolicy{}, design.Policy()), nil
+		}
+
+		de := types.NewDesign(design.Contract(), design.Creator(), design.Active(), proposal.Policy())
+		sts = append(sts, state.NewStateMergeValue(
+			statenft.NFTStateKey(fact.Contract(), statenft.CollectionKey),
+			statenft.NewCollectionStateValue(de),
+		))
+
+		nsts, err := claimCollectionName(policy.Name(), proposal.Policy().Name(), fact.Contract(), getStateFunc)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("failed to claim collection name, %v: %w", proposal.Policy().Name(), err), nil
+		}
+		sts = append(sts, nsts...)
+	}
+
+	sts = append(sts, state.NewStateMergeValue(
+		statenft.StateKeyProposal(fact.Contract(), fact.Proposal()),
+		statenft.NewProposalStateValue(types.NewProposal(
+			proposal.ID(),
+			proposal.Proposer(),
+			proposal.Policy(),
+			proposal.Action(),
+			approvals,
+			proposal.ExpireHeight(),
+			status,
+		)),
+	))
+
+	feeSts, err := payCollectionItemsFee(fact.Sender(), []CollectionItem{fact}, getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *ApproveProposalProcessor) Close() error {
+	approveProposalProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	BridgeLockFactHint = hint.MustNewHint("mitum-nft-bridge-lock-operation-fact-v0.0.1")
+	BridgeLockHint     = hint.MustNewHint("mitum-nft-bridge-lock-operation-v0.0.1")
+)
+
+// BridgeLockFact moves nft of idx into the bridge vault of collection to
+// bridge it to recipient of the remote chain.
+type BridgeLockFact struct {
+	mitumbase.BaseFact
+	sender    mitumbase.Address
+	contract  mitumbase.Address
+	idx       uint64
+	chain     string
+	recipient string
+	currency  currencytypes.CurrencyID
+}
+
+func NewBridgeLockFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	idx uint64,
+	chain, recipient string,
+	currency currencytypes.CurrencyID,
+) BridgeLockFact {
+	bf := mitumbase.NewBaseFact(BridgeLockFactHint, token)
+
+	fact := BridgeLockFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		idx:       idx,
+		chain:     chain,
+		recipient: recipient,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BridgeLockFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := types.IsValidBridgeDestination(fact.chain, fact.recipient); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact BridgeLockFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BridgeLockFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BridgeLockFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.idx),
+		[]byte(fact.chain),
+		[]byte(fact.recipient),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact BridgeLockFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BridgeLockFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact BridgeLockFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact BridgeLockFact) NFT() uint64 {
+	return fact.idx
+}
+
+func (fact BridgeLockFact) Chain() string {
+	return fact.chain
+}
+
+func (fact BridgeLockFact) Recipient() string {
+	return fact.recipient
+}
+
+func (fact BridgeLockFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact BridgeLockFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type BridgeLock struct {
+	common.BaseOperation
+}
+
+func NewBridgeLock(fact BridgeLockFact) (BridgeLock, error) {
+	return BridgeLock{BaseOperation: common.NewBaseOperation(BridgeLockHint, fact)}, nil
+}
@@ -0,0 +1,36 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *BridgeLockFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	idx uint64,
+	ch string,
+	rc string,
+	cid string,
+) error {
+	fact.idx = idx
+	fact.chain = ch
+	fact.recipient = rc
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
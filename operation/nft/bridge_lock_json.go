@@ -0,0 +1,77 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type BridgeLockFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender    mitumbase.Address        `json:"sender"`
+	Contract  mitumbase.Address        `json:"contract"`
+	NFT       uint64                   `json:"nft_idx"`
+	Chain     string                   `json:"chain"`
+	Recipient string                   `json:"recipient"`
+	Currency  currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact BridgeLockFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BridgeLockFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		NFT:                   fact.idx,
+		Chain:                 fact.chain,
+		Recipient:             fact.recipient,
+		Currency:              fact.currency,
+	})
+}
+
+type BridgeLockFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender    string `json:"sender"`
+	Contract  string `json:"contract"`
+	NFT       uint64 `json:"nft_idx"`
+	Chain     string `json:"chain"`
+	Recipient string `json:"recipient"`
+	Currency  string `json:"currency"`
+}
+
+func (fact *BridgeLockFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u BridgeLockFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.NFT, u.Chain, u.Recipient, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type BridgeLockMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op BridgeLock) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BridgeLockMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *BridgeLock) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var bridgeLockProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BridgeLockProcessor)
+	},
+}
+
+func (BridgeLock) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type BridgeLockProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewBridgeLockProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new BridgeLockProcessor")
+
+		nopp := bridgeLockProcessorPool.Get()
+		opp, ok := nopp.(*BridgeLockProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected BridgeLockProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *BridgeLockProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(BridgeLockFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", BridgeLockFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v", cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, err := activeCollection(fact.Contract(), "contract", getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(statenft.NFTStateKey(fact.Contract(), statenft.RelayersKey), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateNF).Errorf("relayer set for contract account %v", fact.Contract())), nil
+	}
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateNF).Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateInvalid).Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if !nv.Active() {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("burned nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if !nv.Owner().Equal(fact.Sender()) {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not owner of nft idx %v in contract account %v", fact.Sender(), fact.NFT(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+// Process moves nft into the bridge vault, the contract account, and records
+// the destination of the remote chain with the next nonce of nft.
+func (opp *BridgeLockProcessor) Process(
+	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process BridgeLock")
+	fact, ok := op.Fact().(BridgeLockFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected BridgeLockFact, not %T", op.Fact())
+	}
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("nft not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("nft value not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	var nonce uint64
+	switch st, found, err := getStateFunc(statenft.StateKeyNFTBridge(fact.Contract(), fact.NFT())); {
+	case err != nil:
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to get bridge lock, %v: %w", fact.NFT(), err), nil
+	case found:
+		lock, err := statenft.StateBridgeLockValue(st)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("bridge lock value not found, %v: %w", fact.NFT(), err), nil
+		}
+		nonce = lock.Nonce() + 1
+	}
+
+	n := types.NewNFT(
+		nv.ID(), nv.Active(), fact.Contract(), nv.NFTHash(), nv.URI(), fact.Contract(), nv.Creators(), nv.Attributes(), nv.Provenance())
+	if err := n.IsValid(nil); err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("invalid nft, %v: %w", fact.NFT(), err), nil
+	}
+
+	lock := types.NewBridgeLock(nv.Owner(), fact.Chain(), fact.Recipient(), nonce, true)
+	if err := lock.IsValid(nil); err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("invalid bridge lock, %v: %w", fact.NFT(), err), nil
+	}
+
+	var sts []mitumbase.StateMergeValue
+	sts = append(sts,
+		state.NewStateMergeValue(statenft.StateKeyNFT(fact.Contract(), fact.NFT()), statenft.NewNFTStateValue(n)),
+		state.NewStateMergeValue(statenft.StateKeyNFTBridge(fact.Contract(), fact.NFT()), statenft.NewBridgeLockStateValue(lock)),
+	)
+
+	currencyPolicy, err := state.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("currency not found, %v: %w", fact.Currency(), err), nil
+	}
+
+	if currencyPolicy.Feeer().Receiver() == nil {
+		return sts, nil, nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"failed to check fee of currency, %v: %w",
+			fact.Currency(),
+			err,
+		), nil
+	}
+
+	senderBalSt, err := state.ExistsState(
+		statecurrency.BalanceStateKey(fact.Sender(), fact.Currency()),
+		"key of sender balance",
+		getStateFunc,
+	)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"sender balance not found, %v: %w",
+			fact.Sender(),
+			err,
+		), nil
+	}
+
+	switch senderBal, err := statecurrency.StateBalanceValue(senderBalSt); {
+	case err != nil:
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"failed to get balance value, %v: %w",
+			statecurrency.BalanceStateKey(fact.Sender(), fact.Currency()),
+			err,
+		), nil
+	case senderBal.Big().Compare(fee) < 0:
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"not enough balance of sender, %v",
+			fact.Sender(),
+		), nil
+	}
+
+	v, ok := senderBalSt.Value().(statecurrency.BalanceStateValue)
+	if !ok {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.AccountStateKey(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+		return nil, nil, err
+	} else if feeRcvrSt, found, err := getStateFunc(statecurrency.BalanceStateKey(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+		return nil, nil, err
+	} else if !found {
+		return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+	} else if feeRcvrSt.Key() != senderBalSt.Key() {
+		r, ok := feeRcvrSt.Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, nil, errors.Errorf("expected %T, not %T", statecurrency.BalanceStateValue{}, feeRcvrSt.Value())
+		}
+		sts = append(sts, common.NewBaseStateMergeValue(
+			feeRcvrSt.Key(),
+			statecurrency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+			func(height mitumbase.Height, st mitumbase.State) mitumbase.StateValueMerger {
+				return statecurrency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+			},
+		))
+
+		sts = append(sts, common.NewBaseStateMergeValue(
+			senderBalSt.Key(),
+			statecurrency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+			func(height mitumbase.Height, st mitumbase.State) mitumbase.StateValueMerger {
+				return statecurrency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+			},
+		))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *BridgeLockProcessor) Close() error {
+	bridgeLockProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	BridgeReleaseFactHint = hint.MustNewHint("mitum-nft-bridge-release-operation-fact-v0.0.1")
+	BridgeReleaseHint     = hint.MustNewHint("mitum-nft-bridge-release-operation-v0.0.1")
+)
+
+// BridgeReleaseFact returns nft of idx in the bridge vault to receiver with
+// the relayer signs over the release message of the bridge lock.
+type BridgeReleaseFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	idx      uint64
+	receiver mitumbase.Address
+	signs    []types.RelayerSign
+	currency currencytypes.CurrencyID
+}
+
+func NewBridgeReleaseFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	idx uint64,
+	receiver mitumbase.Address,
+	signs []types.RelayerSign,
+	currency currencytypes.CurrencyID,
+) BridgeReleaseFact {
+	bf := mitumbase.NewBaseFact(BridgeReleaseFactHint, token)
+
+	fact := BridgeReleaseFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		idx:      idx,
+		receiver: receiver,
+		signs:    signs,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BridgeReleaseFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.receiver.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("receiver %v is same with contract", fact.receiver)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.receiver,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := len(fact.signs); l < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty relayer signs")))
+	} else if l > types.MaxRelayers {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("relayer signs over allowed, %d > %d", l, types.MaxRelayers)))
+	}
+
+	for _, s := range fact.signs {
+		if err := s.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact BridgeReleaseFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BridgeReleaseFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BridgeReleaseFact) Bytes() []byte {
+	ss := make([][]byte, len(fact.signs))
+	for i, s := range fact.signs {
+		ss[i] = s.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.idx),
+		fact.receiver.Bytes(),
+		util.ConcatBytesSlice(ss...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact BridgeReleaseFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BridgeReleaseFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact BridgeReleaseFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact BridgeReleaseFact) NFT() uint64 {
+	return fact.idx
+}
+
+func (fact BridgeReleaseFact) Receiver() mitumbase.Address {
+	return fact.receiver
+}
+
+func (fact BridgeReleaseFact) Signs() []types.RelayerSign {
+	return fact.signs
+}
+
+func (fact BridgeReleaseFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact BridgeReleaseFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.receiver
+	return as, nil
+}
+
+type BridgeRelease struct {
+	common.BaseOperation
+}
+
+func NewBridgeRelease(fact BridgeReleaseFact) (BridgeRelease, error) {
+	return BridgeRelease{BaseOperation: common.NewBaseOperation(BridgeReleaseHint, fact)}, nil
+}
@@ -0,0 +1,47 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *BridgeReleaseFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	idx uint64,
+	rc string,
+	bss []byte,
+	cid string,
+) error {
+	fact.idx = idx
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	receiver, err := mitumbase.DecodeAddress(rc, enc)
+	if err != nil {
+		return err
+	}
+	fact.receiver = receiver
+
+	signs, err := types.DecodeRelayerSigns(enc, bss)
+	if err != nil {
+		return err
+	}
+	fact.signs = signs
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type BridgeReleaseFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender   mitumbase.Address        `json:"sender"`
+	Contract mitumbase.Address        `json:"contract"`
+	NFT      uint64                   `json:"nft_idx"`
+	Receiver mitumbase.Address        `json:"receiver"`
+	Signs    []types.RelayerSign      `json:"relayer_signs"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact BridgeReleaseFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BridgeReleaseFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		NFT:                   fact.idx,
+		Receiver:              fact.receiver,
+		Signs:                 fact.signs,
+		Currency:              fact.currency,
+	})
+}
+
+type BridgeReleaseFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Contract string          `json:"contract"`
+	NFT      uint64          `json:"nft_idx"`
+	Receiver string          `json:"receiver"`
+	Signs    json.RawMessage `json:"relayer_signs"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *BridgeReleaseFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u BridgeReleaseFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.NFT, u.Receiver, u.Signs, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type BridgeReleaseMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op BridgeRelease) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BridgeReleaseMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *BridgeRelease) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
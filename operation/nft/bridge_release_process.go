@@ -0,0 +1,257 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var bridgeReleaseProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BridgeReleaseProcessor)
+	},
+}
+
+func (BridgeRelease) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type BridgeReleaseProcessor struct {
+	*mitumbase.BaseOperationProcessor
+	networkID mitumbase.NetworkID
+}
+
+func NewBridgeReleaseProcessor(networkID mitumbase.NetworkID) currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new BridgeReleaseProcessor")
+
+		nopp := bridgeReleaseProcessorPool.Get()
+		opp, ok := nopp.(*BridgeReleaseProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected BridgeReleaseProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.networkID = networkID
+
+		return opp, nil
+	}
+}
+
+func (opp *BridgeReleaseProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(BridgeReleaseFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", BridgeReleaseFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v", cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, _, cErr := state.ExistsCAccount(
+		fact.Receiver(), "receiver", true, false, getStateFunc); cErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: receiver %v is contract account", cErr, fact.Receiver())), nil
+	}
+
+	if _, _, err := activeCollection(fact.Contract(), "contract", getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	st, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.RelayersKey), "relayer set", getStateFunc)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateNF).Errorf("relayer set for contract account %v", fact.Contract())), nil
+	}
+
+	relayers, err := statenft.StateRelayerSetValue(st)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateInvalid).Errorf("relayer set for contract account %v", fact.Contract())), nil
+	}
+
+	st, err = state.ExistsState(statenft.StateKeyNFTBridge(fact.Contract(), fact.NFT()), "bridge lock", getStateFunc)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateNF).Errorf("bridge lock of nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	lock, err := statenft.StateBridgeLockValue(st)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateInvalid).Errorf("bridge lock of nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if !lock.Active() {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("nft idx %v in contract account %v has already been released", fact.NFT(), fact.Contract())), nil
+	}
+
+	st, err = state.ExistsState(statenft.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateNF).Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateInvalid).Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if !nv.Active() || !nv.Owner().Equal(fact.Contract()) {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("nft idx %v is not held in bridge vault of contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if err := relayers.Verify(
+		lock.ReleaseMessage(opp.networkID, fact.Contract(), fact.NFT(), fact.Receiver()), fact.Signs()); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("bridge proof of nft idx %v in contract account %v: %v", fact.NFT(), fact.Contract(), err)), nil
+	}
+
+	if err := checkRestriction(fact.Contract(), fact.Receiver(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+// Process returns nft in the bridge vault to receiver and deactivates the
+// bridge lock; the nonce is kept for the next lock of nft.
+func (opp *BridgeReleaseProcessor) Process(
+	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process BridgeRelease")
+	fact, ok := op.Fact().(BridgeReleaseFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected BridgeReleaseFact, not %T", op.Fact())
+	}
+
+	var sts []mitumbase.StateMergeValue
+
+	smv, err := state.CreateNotExistAccount(fact.Receiver(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("%w", err), nil
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("nft not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("nft value not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	st, err = state.ExistsState(statenft.StateKeyNFTBridge(fact.Contract(), fact.NFT()), "bridge lock", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("bridge lock not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	lock, err := statenft.StateBridgeLockValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("bridge lock value not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	n := types.NewNFT(
+		nv.ID(), nv.Active(), fact.Receiver(), nv.NFTHash(), nv.URI(), fact.Receiver(), nv.Creators(), nv.Attributes(), nv.Provenance())
+	if err := n.IsValid(nil); err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("invalid nft, %v: %w", fact.NFT(), err), nil
+	}
+
+	released := types.NewBridgeLock(lock.Owner(), lock.Chain(), lock.Recipient(), lock.Nonce(), false)
+
+	sts = append(sts,
+		state.NewStateMergeValue(statenft.StateKeyNFT(fact.Contract(), fact.NFT()), statenft.NewNFTStateValue(n)),
+		state.NewStateMergeValue(statenft.StateKeyNFTBridge(fact.Contract(), fact.NFT()), statenft.NewBridgeLockStateValue(released)),
+	)
+
+	feeSts, err := payCollectionItemsFee(fact.Sender(), []CollectionItem{fact}, getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *BridgeReleaseProcessor) Close() error {
+	bridgeReleaseProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package nft
+
+import (
+	"strconv"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ClaimFactHint = hint.MustNewHint("mitum-nft-claim-operation-fact-v0.0.1")
+	ClaimHint     = hint.MustNewHint("mitum-nft-claim-operation-v0.0.1")
+)
+
+var MaxClaimItems = 100
+
+type ClaimFact struct {
+	mitumbase.BaseFact
+	sender mitumbase.Address
+	items  []ClaimItem
+}
+
+func NewClaimFact(token []byte, sender mitumbase.Address, items []ClaimItem) ClaimFact {
+	bf := mitumbase.NewBaseFact(ClaimFactHint, token)
+	fact := ClaimFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := len(fact.items); l < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty items for ClaimFact")))
+	} else if l > int(MaxClaimItems) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, MaxClaimItems)))
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.sender.Equal(item.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+		}
+
+		nid := item.contract.String() + "-" + strconv.FormatUint(item.NFT(), 10)
+		if _, found := founds[nid]; found {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrDupVal.Wrap(
+					errors.Errorf("nft idx %v in contract account %v", item.NFT(), item.contract)))
+		}
+
+		founds[nid] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ClaimFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ClaimFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ClaimFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact ClaimFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ClaimFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact ClaimFact) Items() []ClaimItem {
+	return fact.items
+}
+
+func (fact ClaimFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type Claim struct {
+	common.BaseOperation
+}
+
+func NewClaim(fact ClaimFact) (Claim, error) {
+	return Claim{BaseOperation: common.NewBaseOperation(ClaimHint, fact)}, nil
+}
@@ -0,0 +1,54 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var ClaimItemHint = hint.MustNewHint("mitum-nft-claim-item-v0.0.1")
+
+type ClaimItem struct {
+	hint.BaseHinter
+	contract mitumbase.Address
+	nftIdx   uint64
+	currency types.CurrencyID
+}
+
+func NewClaimItem(contract mitumbase.Address, nftIdx uint64, currency types.CurrencyID) ClaimItem {
+	return ClaimItem{
+		BaseHinter: hint.NewBaseHinter(ClaimItemHint),
+		contract:   contract,
+		nftIdx:     nftIdx,
+		currency:   currency,
+	}
+}
+
+func (it ClaimItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		util.Uint64ToBytes(it.nftIdx),
+		it.currency.Bytes(),
+	)
+}
+
+func (it ClaimItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.currency,
+	)
+}
+
+func (it ClaimItem) NFT() uint64 {
+	return it.nftIdx
+}
+
+func (it ClaimItem) Contract() mitumbase.Address {
+	return it.contract
+}
+
+func (it ClaimItem) Currency() types.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,44 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it ClaimItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"contract": it.contract,
+			"nft_idx":  it.nftIdx,
+			"currency": it.currency,
+		},
+	)
+}
+
+type ClaimItemBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Contract string `bson:"contract"`
+	NFTIdx   uint64 `bson:"nft_idx"`
+	Currency string `bson:"currency"`
+}
+
+func (it *ClaimItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u ClaimItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	if err := it.unpack(enc, ht, u.Contract, u.NFTIdx, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+	return nil
+}
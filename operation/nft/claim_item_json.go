@@ -0,0 +1,46 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ClaimItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract mitumbase.Address `json:"contract"`
+	NFTIdx   uint64            `json:"nft_idx"`
+	Currency types.CurrencyID  `json:"currency"`
+}
+
+func (it ClaimItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ClaimItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		NFTIdx:     it.nftIdx,
+		Currency:   it.currency,
+	})
+}
+
+type ClaimItemJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	NFTIdx   uint64    `json:"nft_idx"`
+	Currency string    `json:"currency"`
+}
+
+func (it *ClaimItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ClaimItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	if err := it.unpack(enc, u.Hint, u.Contract, u.NFTIdx, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	return nil
+}
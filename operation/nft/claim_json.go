@@ -0,0 +1,67 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ClaimFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender mitumbase.Address `json:"sender"`
+	Items  []ClaimItem       `json:"items"`
+}
+
+func (fact ClaimFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ClaimFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type ClaimFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *ClaimFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ClaimFactJSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Items); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ClaimMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Claim) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ClaimMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Claim) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,347 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var claimItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ClaimItemProcessor)
+	},
+}
+
+var claimProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ClaimProcessor)
+	},
+}
+
+func (Claim) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ClaimItemProcessor struct {
+	h      util.Hash
+	sender mitumbase.Address
+	item   ClaimItem
+	height mitumbase.Height
+}
+
+func (ipp *ClaimItemProcessor) PreProcess(
+	_ context.Context, _ mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) error {
+	e := util.StringError("preprocess ClaimItemProcessor")
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.DesignStateKey(it.Currency()), getStateFunc); err != nil {
+		return e.Wrap(common.ErrCurrencyNF.Wrap(errors.Errorf("currency id %v", it.Currency())))
+	}
+
+	_, _, aErr, cErr := currencystate.ExistsCAccount(it.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return e.Wrap(aErr)
+	} else if cErr != nil {
+		return e.Wrap(cErr)
+	}
+
+	nid := it.NFT()
+
+	st, err := state.ExistsState(
+		statenft.NFTStateKey(it.Contract(), statenft.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return e.Wrap(
+			common.ErrStateNF.Wrap(
+				common.ErrServiceNF.Errorf("nft collection state for contract account %v", it.Contract())))
+	}
+
+	design, err := statenft.StateCollectionValue(st)
+	if err != nil {
+		return e.Wrap(
+			common.ErrStateValInvalid.Wrap(
+				common.ErrServiceNF.Errorf("nft collection state value for contract account %v", it.Contract())))
+	}
+	if !design.Active() {
+		return e.Wrap(
+			errors.Errorf(
+				"nft collection in contract account %v has already been deactivated ", it.Contract()))
+	}
+
+	st, err = state.ExistsState(statenft.StateKeyNFT(it.Contract(), nid), "nft", getStateFunc)
+	if err != nil {
+		return e.Wrap(common.ErrStateNF.Errorf("nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return e.Wrap(common.ErrStateValInvalid.Errorf("nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	if !nv.Active() {
+		return e.Wrap(errors.Errorf("burned nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	st, err = state.ExistsState(statenft.StateKeyNFTLock(it.Contract(), nid), "nft lock", getStateFunc)
+	if err != nil {
+		return e.Wrap(common.ErrStateNF.Errorf("lock of nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	lock, err := statenft.StateNFTLockValue(st)
+	if err != nil {
+		return e.Wrap(
+			common.ErrStateValInvalid.Errorf("lock of nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	if !lock.Active() || !nv.Owner().Equal(it.Contract()) {
+		return e.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("nft idx %v in contract account %v is not locked", nid, it.Contract())))
+	}
+
+	switch {
+	case ipp.sender.Equal(lock.Receiver()):
+		if ipp.height < lock.ReleaseHeight() {
+			return e.Wrap(common.ErrValueInvalid.Wrap(
+				errors.Errorf(
+					"nft idx %v in contract account %v is locked until height %v, current height %v",
+					nid, it.Contract(), lock.ReleaseHeight(), ipp.height)))
+		}
+
+		// the restriction list may be updated while nft is locked
+		if err := checkRestriction(it.Contract(), lock.Receiver(), getStateFunc); err != nil {
+			return e.Wrap(err)
+		}
+	case ipp.sender.Equal(lock.Owner()):
+		if ipp.height >= lock.ReleaseHeight() {
+			return e.Wrap(common.ErrValueInvalid.Wrap(
+				errors.Errorf(
+					"lock of nft idx %v in contract account %v can not be cancelled from height %v, current height %v",
+					nid, it.Contract(), lock.ReleaseHeight(), ipp.height)))
+		}
+	default:
+		return e.Wrap(common.ErrAccountNAth.Wrap(
+			errors.Errorf("sender %v neither receiver nor owner of lock of nft idx %v in contract account %v",
+				ipp.sender, nid, it.Contract())))
+	}
+
+	return nil
+}
+
+func (ipp *ClaimItemProcessor) Process(
+	_ context.Context, _ mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, error) {
+	contract := ipp.item.Contract()
+	nid := ipp.item.NFT()
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(contract, nid), "nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %v: %v", nid, err)
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %v: %v", nid, err)
+	}
+
+	st, err = state.ExistsState(statenft.StateKeyNFTLock(contract, nid), "nft lock", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft lock not found, %v: %v", nid, err)
+	}
+
+	lock, err := statenft.StateNFTLockValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft lock value not found, %v: %v", nid, err)
+	}
+
+	// the receiver claims the nft, otherwise the owner cancels the lock and takes the nft back
+	holder := lock.Owner()
+	if ipp.sender.Equal(lock.Receiver()) {
+		holder = lock.Receiver()
+	}
+
+	n := types.NewNFT(nid, nv.Active(), holder, nv.NFTHash(), nv.URI(), holder, nv.Creators(), nv.Attributes(), nv.Provenance())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %v: %v", nid, err)
+	}
+
+	released := types.NewNFTLock(lock.Owner(), lock.Receiver(), lock.ReleaseHeight(), false)
+
+	return []mitumbase.StateMergeValue{
+		state.NewStateMergeValue(statenft.StateKeyNFT(contract, nid), statenft.NewNFTStateValue(n)),
+		state.NewStateMergeValue(statenft.StateKeyNFTLock(contract, nid), statenft.NewNFTLockStateValue(released)),
+	}, nil
+}
+
+func (ipp *ClaimItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = ClaimItem{}
+	ipp.height = 0
+
+	claimItemProcessorPool.Put(ipp)
+
+	return
+}
+
+type ClaimProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewClaimProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new ClaimProcessor")
+
+		nopp := claimProcessorPool.Get()
+		opp, ok := nopp.(*ClaimProcessor)
+		if !ok {
+			return nil, e.Errorf("expected ClaimProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ClaimProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(ClaimFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ClaimFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", fact.Sender(), cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := claimItemProcessorPool.Get()
+		ipc, ok := ip.(*ClaimItemProcessor)
+		if !ok {
+			return nil, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMTypeMismatch.Errorf("expected ClaimItemProcessor, not %T", ip)), nil
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err),
+			), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ClaimProcessor) Process( // nolint:dupl
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process Claim")
+
+	fact, ok := op.Fact().(ClaimFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected ClaimFact, not %T", op.Fact())
+	}
+
+	var sts []mitumbase.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := claimItemProcessorPool.Get()
+		ipc, ok := ip.(*ClaimItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected ClaimItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("failed to process ClaimItem; %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	items := make([]CollectionItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	feeSts, err := payCollectionItemsFee(fact.Sender(), items, getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *ClaimProcessor) Close() error {
+	claimProcessorPool.Put(opp)
+
+	return nil
+}
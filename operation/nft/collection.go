@@ -0,0 +1,253 @@
+package nft
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	stateextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// collectionPolicy loads the policy of the collection in contract.
+func collectionPolicy(contract mitumbase.Address, getStateFunc mitumbase.GetStateFunc) (types.CollectionPolicy, error) {
+	st, err := currencystate.ExistsState(statenft.NFTStateKey(contract, statenft.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return types.CollectionPolicy{}, common.ErrServiceNF.Wrap(
+			errors.Errorf("nft collection state for contract account %v", contract))
+	}
+
+	design, err := statenft.StateCollectionValue(st)
+	if err != nil {
+		return types.CollectionPolicy{}, common.ErrStateValInvalid.Wrap(
+			errors.Errorf("nft collection state value for contract account %v", contract))
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return types.CollectionPolicy{}, common.ErrTypeMismatch.Wrap(
+			errors.Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy()))
+	}
+
+	return policy, nil
+}
+
+// isUnregisteredCollection reports whether the collection in contract has been
+// unregistered by UnregisterModel, so contract can register a collection again.
+func isUnregisteredCollection(contract mitumbase.Address, getStateFunc mitumbase.GetStateFunc) (bool, error) {
+	switch st, found, err := getStateFunc(statenft.NFTStateKey(contract, statenft.CollectionKey)); {
+	case err != nil:
+		return false, err
+	case !found:
+		return false, nil
+	default:
+		design, err := statenft.StateCollectionValue(st)
+		if err != nil {
+			return false, common.ErrStateValInvalid.Wrap(
+				errors.Errorf("nft collection state value for contract account %v", contract))
+		}
+
+		return !design.Active(), nil
+	}
+}
+
+// supplyStateMergeValue changes the supply of the collection in contract by
+// delta. It returns nil for the collection registered before the supply was
+// kept, whose supply is unknown.
+func supplyStateMergeValue(
+	contract mitumbase.Address, delta int64, getStateFunc mitumbase.GetStateFunc,
+) (mitumbase.StateMergeValue, error) {
+	key := statenft.NFTStateKey(contract, statenft.SupplyKey)
+	switch _, found, err := getStateFunc(key); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	}
+
+	return common.NewBaseStateMergeValue(
+		key,
+		statenft.NewSupplyDeltaStateValue(delta),
+		func(height mitumbase.Height, st mitumbase.State) mitumbase.StateValueMerger {
+			return statenft.NewSupplyStateValueMerger(height, key, st)
+		},
+	), nil
+}
+
+// collectionNameClaim loads the claim of name; it returns nil when name has never been claimed.
+func collectionNameClaim(
+	name types.CollectionName, getStateFunc mitumbase.GetStateFunc,
+) (*statenft.CollectionNameStateValue, error) {
+	switch st, found, err := getStateFunc(statenft.StateKeyCollectionName(name)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		cn, err := statenft.StateCollectionNameValue(st)
+		if err != nil {
+			return nil, common.ErrStateValInvalid.Wrap(errors.Errorf("collection name %q: %v", name, err))
+		}
+
+		return cn, nil
+	}
+}
+
+// checkCollectionName checks that name is not claimed by a collection of other contract account.
+func checkCollectionName(
+	name types.CollectionName, contract mitumbase.Address, getStateFunc mitumbase.GetStateFunc,
+) error {
+	cn, err := collectionNameClaim(name, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if cn != nil && cn.IsClaimedByOther(contract) {
+		return common.ErrStateE.Wrap(
+			errors.Errorf("collection name %q already claimed by contract account %v", cn.Name, cn.Contract))
+	}
+
+	return nil
+}
+
+// claimedNamesContextKey keeps the normalized collection names claimed by the
+// operations preprocessed before in the same block.
+type claimedNamesContextKey struct{}
+
+// checkCollectionNameInBlock checks that name is not claimed yet by a
+// collection of other contract account in the same block; the claims are
+// checked against the state before the block, so the later claim would
+// overwrite the former one. It returns the context which carries the claimed
+// names.
+func checkCollectionNameInBlock(
+	ctx context.Context, name types.CollectionName, contract mitumbase.Address,
+) (context.Context, error) {
+	key := name.Normalize()
+
+	prev, _ := ctx.Value(claimedNamesContextKey{}).(map[string]string)
+	if c, found := prev[key]; found {
+		if c == contract.String() {
+			return ctx, nil
+		}
+
+		return ctx, common.ErrStateE.Wrap(
+			errors.Errorf("collection name %q already claimed by contract account %v in this block", name, c))
+	}
+
+	claimed := make(map[string]string, len(prev)+1)
+	for k := range prev {
+		claimed[k] = prev[k]
+	}
+	claimed[key] = contract.String()
+
+	return context.WithValue(ctx, claimedNamesContextKey{}, claimed), nil
+}
+
+// claimCollectionName returns state merge values which claim name for contract
+// and release prev when contract renames its collection. A collection keeps
+// its current name even though it was claimed by other collection before the
+// name registry.
+func claimCollectionName(
+	prev, name types.CollectionName, contract mitumbase.Address, getStateFunc mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, error) {
+	cn, err := collectionNameClaim(name, getStateFunc)
+	switch {
+	case err != nil:
+		return nil, err
+	case cn != nil && cn.IsClaimedByOther(contract):
+		if prev.Normalize() == name.Normalize() {
+			return nil, nil
+		}
+
+		return nil, common.ErrStateE.Wrap(
+			errors.Errorf("collection name %q already claimed by contract account %v", cn.Name, cn.Contract))
+	}
+
+	sts := []mitumbase.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			statenft.StateKeyCollectionName(name),
+			statenft.NewCollectionNameStateValue(name, contract),
+		),
+	}
+
+	if prev == "" || prev.Normalize() == name.Normalize() {
+		return sts, nil
+	}
+
+	rsts, err := releaseCollectionName(prev, contract, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sts, rsts...), nil
+}
+
+// releaseCollectionName returns state merge values which release name when it is claimed by contract.
+func releaseCollectionName(
+	name types.CollectionName, contract mitumbase.Address, getStateFunc mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, error) {
+	switch cn, err := collectionNameClaim(name, getStateFunc); {
+	case err != nil:
+		return nil, err
+	case cn == nil || cn.Contract == nil || !cn.Contract.Equal(contract):
+		return nil, nil
+	}
+
+	return []mitumbase.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			statenft.StateKeyCollectionName(name),
+			statenft.NewCollectionNameStateValue(name, nil),
+		),
+	}, nil
+}
+
+// activeCollection loads the active collection design and its policy in
+// contract account; name describes the role of contract in the error messages.
+func activeCollection(
+	contract mitumbase.Address, name string, getStateFunc mitumbase.GetStateFunc,
+) (types.Design, types.CollectionPolicy, error) {
+	_, cSt, aErr, cErr := currencystate.ExistsCAccount(contract, name, true, true, getStateFunc)
+	if aErr != nil {
+		return types.Design{}, types.CollectionPolicy{}, aErr
+	} else if cErr != nil {
+		return types.Design{}, types.CollectionPolicy{}, cErr
+	}
+
+	ca, err := stateextension.LoadCAStateValue(cSt)
+	if err != nil {
+		return types.Design{}, types.CollectionPolicy{}, err
+	}
+
+	if !ca.IsActive() {
+		return types.Design{}, types.CollectionPolicy{}, common.ErrServiceNF.Wrap(
+			errors.Errorf("%v contract account %v is not active", name, contract))
+	}
+
+	st, err := currencystate.ExistsState(statenft.NFTStateKey(contract, statenft.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return types.Design{}, types.CollectionPolicy{}, common.ErrServiceNF.Wrap(
+			errors.Errorf("nft collection state for %v contract account %v", name, contract))
+	}
+
+	design, err := statenft.StateCollectionValue(st)
+	if err != nil {
+		return types.Design{}, types.CollectionPolicy{}, common.ErrStateValInvalid.Wrap(
+			errors.Errorf("nft collection state value for %v contract account %v", name, contract))
+	}
+
+	if !design.Active() {
+		return types.Design{}, types.CollectionPolicy{}, errors.Errorf(
+			"nft collection in %v contract account %v has already been deactivated", name, contract)
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return types.Design{}, types.CollectionPolicy{}, common.ErrTypeMismatch.Wrap(
+			errors.Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy()))
+	}
+
+	return *design, policy, nil
+}
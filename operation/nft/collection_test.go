@@ -0,0 +1,42 @@
+package nft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+)
+
+func TestCheckCollectionNameInBlock(t *testing.T) {
+	a := mitumbase.NewStringAddress("contract0")
+	b := mitumbase.NewStringAddress("contract1")
+
+	ctx, err := checkCollectionNameInBlock(context.Background(), types.CollectionName("Collection"), a)
+	if err != nil {
+		t.Fatalf("first claim: unexpected error, %v", err)
+	}
+
+	// NOTE both claims pass the check against the state before the block, so
+	// the name claimed by the later one would overwrite the former.
+	if _, err := checkCollectionNameInBlock(ctx, types.CollectionName("collection"), b); err == nil {
+		t.Error("same normalized name by other contract: expected error")
+	}
+
+	if _, err := checkCollectionNameInBlock(ctx, types.CollectionName("Collection"), a); err != nil {
+		t.Errorf("same name by same contract: unexpected error, %v", err)
+	}
+
+	nctx, err := checkCollectionNameInBlock(ctx, types.CollectionName("Other"), b)
+	if err != nil {
+		t.Fatalf("other name: unexpected error, %v", err)
+	}
+
+	if _, err := checkCollectionNameInBlock(nctx, types.CollectionName("COLLECTION"), b); err == nil {
+		t.Error("claim after other name: expected error")
+	}
+
+	if _, err := checkCollectionNameInBlock(context.Background(), types.CollectionName("collection"), b); err != nil {
+		t.Errorf("claim in next block: unexpected error, %v", err)
+	}
+}
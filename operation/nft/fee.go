@@ -0,0 +1,65 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// payCollectionItemsFee returns state merge values which move the fee of items
+// from the balance of payer to the fee receivers of the item currencies.
+func payCollectionItemsFee(
+	payer base.Address, items []CollectionItem, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	feeReceiverBalSts, required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, errors.Errorf("failed to calculate fee; %v", err)
+	}
+
+	sb, err := currency.CheckEnoughBalance(payer, required, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to check enough balance of fee payer %v; %v", payer, err)
+	}
+
+	var sts []base.StateMergeValue
+	for cid := range sb {
+		v, ok := sb[cid].Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, common.ErrTypeMismatch.Wrap(
+				errors.Errorf("expected %T, not %T", statecurrency.BalanceStateValue{}, sb[cid].Value()))
+		}
+
+		feeReceiverBalSt, feeReceiverFound := feeReceiverBalSts[cid]
+		if !feeReceiverFound || sb[cid].Key() == feeReceiverBalSt.Key() {
+			continue
+		}
+
+		r, ok := feeReceiverBalSt.Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, common.ErrTypeMismatch.Wrap(
+				errors.Errorf("expected %T, not %T", statecurrency.BalanceStateValue{}, feeReceiverBalSt.Value()))
+		}
+
+		sts = append(
+			sts,
+			common.NewBaseStateMergeValue(
+				feeReceiverBalSt.Key(),
+				statecurrency.NewAddBalanceStateValue(r.Amount.WithBig(required[cid][1])),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return statecurrency.NewBalanceStateValueMerger(height, feeReceiverBalSt.Key(), cid, st)
+				},
+			),
+			common.NewBaseStateMergeValue(
+				sb[cid].Key(),
+				statecurrency.NewDeductBalanceStateValue(v.Amount.WithBig(required[cid][1])),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return statecurrency.NewBalanceStateValueMerger(height, sb[cid].Key(), cid, st)
+				},
+			),
+		)
+	}
+
+	return sts, nil
+}
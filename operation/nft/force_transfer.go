@@ -0,0 +1,139 @@
+package nft
+
+import (
+	"strconv"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ForceTransferFactHint = hint.MustNewHint("mitum-nft-force-transfer-operation-fact-v0.0.1")
+	ForceTransferHint     = hint.MustNewHint("mitum-nft-force-transfer-operation-v0.0.1")
+)
+
+var MaxForceTransferItems = 100
+
+type ForceTransferFact struct {
+	mitumbase.BaseFact
+	sender mitumbase.Address
+	items  []ForceTransferItem
+}
+
+func NewForceTransferFact(token []byte, sender mitumbase.Address, items []ForceTransferItem) ForceTransferFact {
+	bf := mitumbase.NewBaseFact(ForceTransferFactHint, token)
+
+	fact := ForceTransferFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ForceTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := len(fact.items); l < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty items for ForceTransferFact")))
+	} else if l > int(MaxForceTransferItems) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, MaxForceTransferItems)))
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.sender.Equal(item.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+		}
+
+		n := item.contract.String() + "-" + strconv.FormatUint(item.NFT(), 10)
+
+		if _, found := founds[n]; found {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrDupVal.Wrap(errors.Errorf("nft idx %v in contract account %v", item.NFT(), item.contract)))
+		}
+
+		founds[n] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ForceTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ForceTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ForceTransferFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact ForceTransferFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ForceTransferFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) Items() []ForceTransferItem {
+	return fact.items
+}
+
+func (fact ForceTransferFact) Addresses() ([]mitumbase.Address, error) {
+	as := []mitumbase.Address{}
+
+	for i := range fact.items {
+		if ads, err := fact.items[i].Addresses(); err != nil {
+			return nil, err
+		} else {
+			as = append(as, ads...)
+		}
+	}
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+type ForceTransfer struct {
+	common.BaseOperation
+}
+
+func NewForceTransfer(fact ForceTransferFact) (ForceTransfer, error) {
+	return ForceTransfer{BaseOperation: common.NewBaseOperation(ForceTransferHint, fact)}, nil
+}
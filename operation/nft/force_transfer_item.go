@@ -0,0 +1,94 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var ForceTransferItemHint = hint.MustNewHint("mitum-nft-force-transfer-item-v0.0.1")
+
+type ForceTransferItem struct {
+	hint.BaseHinter
+	contract mitumbase.Address
+	owner    mitumbase.Address
+	receiver mitumbase.Address
+	nftIdx   uint64
+	currency types.CurrencyID
+}
+
+func NewForceTransferItem(
+	contract mitumbase.Address,
+	owner mitumbase.Address,
+	receiver mitumbase.Address,
+	nft uint64,
+	currency types.CurrencyID,
+) ForceTransferItem {
+	return ForceTransferItem{
+		BaseHinter: hint.NewBaseHinter(ForceTransferItemHint),
+		contract:   contract,
+		owner:      owner,
+		receiver:   receiver,
+		nftIdx:     nft,
+		currency:   currency,
+	}
+}
+
+func (it ForceTransferItem) IsValid([]byte) error {
+	if it.receiver.Equal(it.contract) {
+		return common.ErrSelfTarget.Wrap(errors.Errorf("receiver %v is same with contract account", it.receiver))
+	}
+
+	if it.receiver.Equal(it.owner) {
+		return common.ErrSelfTarget.Wrap(errors.Errorf("receiver %v is same with nft owner", it.receiver))
+	}
+
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.owner,
+		it.receiver,
+		it.currency,
+	)
+}
+
+func (it ForceTransferItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.owner.Bytes(),
+		it.receiver.Bytes(),
+		util.Uint64ToBytes(it.nftIdx),
+		it.currency.Bytes(),
+	)
+}
+
+func (it ForceTransferItem) Contract() mitumbase.Address {
+	return it.contract
+}
+
+// Owner is the current nft owner the nft is taken from.
+func (it ForceTransferItem) Owner() mitumbase.Address {
+	return it.owner
+}
+
+func (it ForceTransferItem) Receiver() mitumbase.Address {
+	return it.receiver
+}
+
+func (it ForceTransferItem) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 2)
+	as[0] = it.owner
+	as[1] = it.receiver
+	return as, nil
+}
+
+func (it ForceTransferItem) NFT() uint64 {
+	return it.nftIdx
+}
+
+func (it ForceTransferItem) Currency() types.CurrencyID {
+	return it.currency
+}
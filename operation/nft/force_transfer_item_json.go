@@ -0,0 +1,52 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ForceTransferItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract mitumbase.Address `json:"contract"`
+	Owner    mitumbase.Address `json:"owner"`
+	Receiver mitumbase.Address `json:"receiver"`
+	NFTIdx   uint64            `json:"nft_idx"`
+	Currency types.CurrencyID  `json:"currency"`
+}
+
+func (it ForceTransferItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ForceTransferItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		Owner:      it.owner,
+		Receiver:   it.receiver,
+		NFTIdx:     it.nftIdx,
+		Currency:   it.currency,
+	})
+}
+
+type ForceTransferItemJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	Owner    string    `json:"owner"`
+	Receiver string    `json:"receiver"`
+	NFTIdx   uint64    `json:"nft_idx"`
+	Currency string    `json:"currency"`
+}
+
+func (it *ForceTransferItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ForceTransferItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	if err := it.unpack(enc, u.Hint, u.Contract, u.Owner, u.Receiver, u.NFTIdx, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	return nil
+}
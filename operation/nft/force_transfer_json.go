@@ -0,0 +1,66 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ForceTransferFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender mitumbase.Address   `json:"sender"`
+	Items  []ForceTransferItem `json:"items"`
+}
+
+func (fact ForceTransferFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ForceTransferFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type ForceTransferFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *ForceTransferFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ForceTransferFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Items); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type forceTransferMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ForceTransfer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(forceTransferMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ForceTransfer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,357 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var forceTransferItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ForceTransferItemProcessor)
+	},
+}
+
+var forceTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ForceTransferProcessor)
+	},
+}
+
+func (ForceTransfer) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ForceTransferItemProcessor struct {
+	h      util.Hash
+	sender mitumbase.Address
+	item   ForceTransferItem
+}
+
+func (ipp *ForceTransferItemProcessor) PreProcess(
+	_ context.Context, _ mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) error {
+	e := util.StringError("preprocess ForceTransferItemProcessor")
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.DesignStateKey(it.Currency()), getStateFunc); err != nil {
+		return e.Wrap(common.ErrCurrencyNF.Wrap(errors.Errorf("currency id %v", it.Currency())))
+	}
+
+	_, _, aErr, cErr := currencystate.ExistsCAccount(it.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return e.Wrap(aErr)
+	} else if cErr != nil {
+		return e.Wrap(cErr)
+	}
+
+	if _, _, _, cErr := currencystate.ExistsCAccount(
+		it.Receiver(), "receiver", true, false, getStateFunc); cErr != nil {
+		return e.Wrap(common.ErrCAccountNA.Wrap(
+			errors.Errorf("%v: receiver %v is contract account", cErr, it.Receiver())))
+	}
+
+	nid := it.NFT()
+
+	st, err := state.ExistsState(
+		statenft.NFTStateKey(it.Contract(), statenft.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return e.Wrap(
+			common.ErrStateNF.Wrap(
+				common.ErrServiceNF.Errorf("nft collection state for contract account %v", it.Contract())))
+	}
+
+	design, err := statenft.StateCollectionValue(st)
+	if err != nil {
+		return e.Wrap(
+			common.ErrStateValInvalid.Wrap(
+				common.ErrServiceNF.Errorf("nft collection state value for contract account %v", it.Contract())))
+	}
+	if !design.Active() {
+		return e.Wrap(
+			errors.Errorf(
+				"nft collection in contract account %v has already been deactivated ", it.Contract()))
+	}
+
+	if !design.Creator().Equal(ipp.sender) {
+		return e.Wrap(common.ErrAccountNAth.Wrap(
+			errors.Errorf("sender %v is not creator of nft collection in contract account %v", ipp.sender, it.Contract())))
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return e.Wrap(common.ErrTypeMismatch.Wrap(
+			errors.Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())))
+	}
+
+	if !policy.Clawback() {
+		return e.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("clawback is not allowed for nft collection in contract account %v", it.Contract())))
+	}
+
+	st, err = state.ExistsState(statenft.StateKeyNFT(it.Contract(), nid), "nft", getStateFunc)
+	if err != nil {
+		return e.Wrap(common.ErrStateNF.Errorf("nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return e.Wrap(common.ErrStateValInvalid.Errorf("nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	if !nv.Active() {
+		return e.Wrap(errors.Errorf("burned nft idx %v in contract account %v", nid, it.Contract()))
+	}
+
+	if !nv.Owner().Equal(it.Owner()) {
+		return e.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("%v is not owner of nft idx %v in contract account %v", it.Owner(), nid, it.Contract())))
+	}
+
+	switch st, found, err := getStateFunc(statenft.StateKeyNFTBridge(it.Contract(), nid)); {
+	case err != nil:
+		return e.Wrap(err)
+	case found:
+		lock, err := statenft.StateBridgeLockValue(st)
+		if err != nil {
+			return e.Wrap(common.ErrStateValInvalid.Errorf("bridge lock of nft idx %v in contract account %v", nid, it.Contract()))
+		}
+
+		if lock.Active() {
+			return e.Wrap(common.ErrValueInvalid.Wrap(
+				errors.Errorf("nft idx %v in contract account %v is bridged to %v", nid, it.Contract(), lock.Chain())))
+		}
+	}
+
+	return nil
+}
+
+func (ipp *ForceTransferItemProcessor) Process(
+	_ context.Context, _ mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, error) {
+	receiver := ipp.item.Receiver()
+	contract := ipp.item.Contract()
+	var sts []mitumbase.StateMergeValue
+
+	smv, err := currencystate.CreateNotExistAccount(receiver, getStateFunc)
+	if err != nil {
+		return nil, err
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	nid := ipp.item.NFT()
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(contract, nid), "nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %v: %v", nid, err)
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %v: %v", nid, err)
+	}
+
+	n := types.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators(), nv.Attributes(), nv.Provenance())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %v: %v", nid, err)
+	}
+
+	sts = append(
+		sts,
+		state.NewStateMergeValue(statenft.StateKeyNFT(contract, nid), statenft.NewNFTStateValue(n)),
+	)
+
+	// an escrowed nft leaves its lock, so the lock can not be claimed anymore
+	switch st, found, err := getStateFunc(statenft.StateKeyNFTLock(contract, nid)); {
+	case err != nil:
+		return nil, err
+	case found:
+		lock, err := statenft.StateNFTLockValue(st)
+		if err != nil {
+			return nil, errors.Errorf("nft lock value not found, %v: %v", nid, err)
+		}
+
+		if lock.Active() {
+			released := types.NewNFTLock(lock.Owner(), lock.Receiver(), lock.ReleaseHeight(), false)
+			sts = append(
+				sts,
+				state.NewStateMergeValue(
+					statenft.StateKeyNFTLock(contract, nid), statenft.NewNFTLockStateValue(released)),
+			)
+		}
+	}
+
+	return sts, nil
+}
+
+func (ipp *ForceTransferItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = ForceTransferItem{}
+
+	forceTransferItemProcessorPool.Put(ipp)
+
+	return
+}
+
+type ForceTransferProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewForceTransferProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new ForceTransferProcessor")
+
+		nopp := forceTransferProcessorPool.Get()
+		opp, ok := nopp.(*ForceTransferProcessor)
+		if !ok {
+			return nil, e.Errorf("expected ForceTransferProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ForceTransferProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(ForceTransferFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ForceTransferFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", fact.Sender(), cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := forceTransferItemProcessorPool.Get()
+		ipc, ok := ip.(*ForceTransferItemProcessor)
+		if !ok {
+			return nil, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMTypeMismatch.Errorf("expected ForceTransferItemProcessor, not %T", ip)), nil
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err),
+			), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ForceTransferProcessor) Process( // nolint:dupl
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process ForceTransfer")
+
+	fact, ok := op.Fact().(ForceTransferFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected ForceTransferFact, not %T", op.Fact())
+	}
+
+	var sts []mitumbase.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := forceTransferItemProcessorPool.Get()
+		ipc, ok := ip.(*ForceTransferItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected ForceTransferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("failed to process ForceTransferItem; %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	items := make([]CollectionItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	feeSts, err := payCollectionItemsFee(fact.Sender(), items, getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *ForceTransferProcessor) Close() error {
+	forceTransferProcessorPool.Put(opp)
+
+	return nil
+}
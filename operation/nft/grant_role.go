@@ -0,0 +1,139 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	GrantRoleFactHint = hint.MustNewHint("mitum-nft-grant-role-operation-fact-v0.0.1")
+	GrantRoleHint     = hint.MustNewHint("mitum-nft-grant-role-operation-v0.0.1")
+)
+
+type GrantRoleFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	account  mitumbase.Address
+	role     types.Role
+	currency currencytypes.CurrencyID
+}
+
+func NewGrantRoleFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	account mitumbase.Address,
+	role types.Role,
+	currency currencytypes.CurrencyID,
+) GrantRoleFact {
+	bf := mitumbase.NewBaseFact(GrantRoleFactHint, token)
+
+	fact := GrantRoleFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		account:  account,
+		role:     role,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GrantRoleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.account,
+		fact.role,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.account.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract", fact.account)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact GrantRoleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GrantRoleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GrantRoleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.account.Bytes(),
+		fact.role.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact GrantRoleFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GrantRoleFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact GrantRoleFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact GrantRoleFact) Account() mitumbase.Address {
+	return fact.account
+}
+
+func (fact GrantRoleFact) Role() types.Role {
+	return fact.role
+}
+
+func (fact GrantRoleFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact GrantRoleFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.account
+	return as, nil
+}
+
+type GrantRole struct {
+	common.BaseOperation
+}
+
+func NewGrantRole(fact GrantRoleFact) (GrantRole, error) {
+	return GrantRole{BaseOperation: common.NewBaseOperation(GrantRoleHint, fact)}, nil
+}
@@ -0,0 +1,288 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var grantRoleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(GrantRoleProcessor)
+	},
+}
+
+func (GrantRole) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type GrantRoleProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewGrantRoleProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new GrantRoleProcessor")
+
+		nopp := grantRoleProcessorPool.Get()
+		opp, ok := nopp.(*GrantRoleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected GrantRoleProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *GrantRoleProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(GrantRoleFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", GrantRoleFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v", cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, _, cErr := state.ExistsCAccount(fact.Account(), "account", true, false, getStateFunc); cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: account %v is contract account", cErr, fact.Account())), nil
+	}
+
+	_, cSt, aErr, cErr := state.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", cErr)), nil
+	}
+
+	if err := checkCollectionAuth(cSt, fact.Contract(), fact.Sender(), getStateFunc, types.RoleAdmin); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkNotGoverned(fact.Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("%v", err)), nil
+	}
+
+	st, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft collection state for contract account %v", fact.Contract())), nil
+
+	}
+
+	design, err := statenft.StateCollectionValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft collection state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("collection in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	if granted, err := hasRole(fact.Contract(), fact.Account(), getStateFunc, fact.Role()); err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateInvalid).
+				Errorf("%v", err)), nil
+	} else if granted {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("role %v already granted to account %v in contract account %v",
+					fact.Role(), fact.Account(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *GrantRoleProcessor) Process(
+	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process GrantRole")
+	fact, ok := op.Fact().(GrantRoleFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected GrantRoleFact, not %T", op.Fact())
+	}
+
+	roles, err := rolesOf(fact.Contract(), fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("roles of account %v: %w", fact.Account(), err), nil
+	}
+
+	var sts []mitumbase.StateMergeValue
+	smv, err := state.CreateNotExistAccount(fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("%w", err), nil
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	nroles := make([]types.Role, len(roles), len(roles)+1)
+	copy(nroles, roles)
+	nroles = append(nroles, fact.Role())
+
+	sts = append(sts, state.NewStateMergeValue(
+		statenft.StateKeyRoles(fact.Contract(), fact.Account()),
+		statenft.NewRolesStateValue(nroles),
+	))
+
+	currencyPolicy, err := state.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("currency not found, %v: %w", fact.Currency(), err), nil
+	}
+
+	if currencyPolicy.Feeer().Receiver() == nil {
+		return sts, nil, nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"failed to check fee of currency, %v: %w",
+			fact.Currency(),
+			err,
+		), nil
+	}
+
+	senderBalSt, err := state.ExistsState(
+		statecurrency.BalanceStateKey(fact.Sender(), fact.Currency()),
+		"key of sender balance",
+		getStateFunc,
+	)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"sender balance not found, %v: %w",
+			fact.Sender(),
+			err,
+		), nil
+	}
+
+	switch senderBal, err := statecurrency.StateBalanceValue(senderBalSt); {
+	case err != nil:
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"failed to get balance value, %v: %w",
+			statecurrency.BalanceStateKey(fact.Sender(), fact.Currency()),
+			err,
+		), nil
+	case senderBal.Big().Compare(fee) < 0:
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			"not enough balance of sender, %v",
+			fact.Sender(),
+		), nil
+	}
+
+	v, ok := senderBalSt.Value().(statecurrency.BalanceStateValue)
+	if !ok {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.AccountStateKey(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+		return nil, nil, err
+	} else if feeRcvrSt, found, err := getStateFunc(statecurrency.BalanceStateKey(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+		return nil, nil, err
+	} else if !found {
+		return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+	} else if feeRcvrSt.Key() != senderBalSt.Key() {
+		r, ok := feeRcvrSt.Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, nil, errors.Errorf("expected %T, not %T", statecurrency.BalanceStateValue{}, feeRcvrSt.Value())
+		}
+		sts = append(sts, common.NewBaseStateMergeValue(
+			feeRcvrSt.Key(),
+			statecurrency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+			func(height mitumbase.Height, st mitumbase.State) mitumbase.StateValueMerger {
+				return statecurrency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+			},
+		))
+
+		sts = append(sts, common.NewBaseStateMergeValue(
+			senderBalSt.Key(),
+			statecurrency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+			func(height mitumbase.Height, st mitumbase.State) mitumbase.StateValueMerger {
+				return statecurrency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+			},
+		))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *GrantRoleProcessor) Close() error {
+	grantRoleProcessorPool.Put(opp)
+
+	return nil
+}
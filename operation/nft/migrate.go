@@ -0,0 +1,143 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	MigrateFactHint = hint.MustNewHint("mitum-nft-migrate-operation-fact-v0.0.1")
+	MigrateHint     = hint.MustNewHint("mitum-nft-migrate-operation-v0.0.1")
+)
+
+// MigrateFact moves nft of idx from the collection in source contract account
+// to the collection in target contract account. The operation should be signed
+// by the nft owner, sender, and the creators of both collections.
+type MigrateFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	source   mitumbase.Address
+	target   mitumbase.Address
+	idx      uint64
+	currency currencytypes.CurrencyID
+}
+
+func NewMigrateFact(
+	token []byte,
+	sender, source, target mitumbase.Address,
+	idx uint64,
+	currency currencytypes.CurrencyID,
+) MigrateFact {
+	bf := mitumbase.NewBaseFact(MigrateFactHint, token)
+
+	fact := MigrateFact{
+		BaseFact: bf,
+		sender:   sender,
+		source:   source,
+		target:   target,
+		idx:      idx,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact MigrateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.source,
+		fact.target,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.source) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with source contract", fact.sender)))
+	}
+
+	if fact.sender.Equal(fact.target) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with target contract", fact.sender)))
+	}
+
+	if fact.source.Equal(fact.target) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("source contract %v is same with target contract", fact.source)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact MigrateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact MigrateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MigrateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.source.Bytes(),
+		fact.target.Bytes(),
+		util.Uint64ToBytes(fact.idx),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact MigrateFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact MigrateFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact MigrateFact) Source() mitumbase.Address {
+	return fact.source
+}
+
+func (fact MigrateFact) Target() mitumbase.Address {
+	return fact.target
+}
+
+func (fact MigrateFact) NFT() uint64 {
+	return fact.idx
+}
+
+func (fact MigrateFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact MigrateFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type Migrate struct {
+	common.BaseOperation
+}
+
+func NewMigrate(fact MigrateFact) (Migrate, error) {
+	return Migrate{BaseOperation: common.NewBaseOperation(MigrateHint, fact)}, nil
+}
@@ -0,0 +1,39 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *MigrateFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	sc string,
+	tg string,
+	idx uint64,
+	cid string,
+) error {
+	fact.idx = idx
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	source, err := mitumbase.DecodeAddress(sc, enc)
+	if err != nil {
+		return err
+	}
+	fact.source = source
+
+	target, err := mitumbase.DecodeAddress(tg, enc)
+	if err != nil {
+		return err
+	}
+	fact.target = target
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type MigrateFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender   mitumbase.Address        `json:"sender"`
+	Source   mitumbase.Address        `json:"source"`
+	Target   mitumbase.Address        `json:"target"`
+	NFT      uint64                   `json:"nft_idx"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact MigrateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigrateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Source:                fact.source,
+		Target:                fact.target,
+		NFT:                   fact.idx,
+		Currency:              fact.currency,
+	})
+}
+
+type MigrateFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	NFT      uint64 `json:"nft_idx"`
+	Currency string `json:"currency"`
+}
+
+func (fact *MigrateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u MigrateFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Source, u.Target, u.NFT, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type MigrateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Migrate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigrateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Migrate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var migrateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(MigrateProcessor)
+	},
+}
+
+func (Migrate) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type MigrateProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewMigrateProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new MigrateProcessor")
+
+		nopp := migrateProcessorPool.Get()
+		opp, ok := nopp.(*MigrateProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected MigrateProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *MigrateProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(MigrateFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", MigrateFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v", cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	source, _, err := activeCollection(fact.Source(), "source", getStateFunc)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	target, policy, err := activeCollection(fact.Target(), "target", getStateFunc)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	for _, creator := range []mitumbase.Address{source.Creator(), target.Creator()} {
+		if err := state.CheckFactSignsByState(creator, op.Signs(), getStateFunc); err != nil {
+			return ctx, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMSignInvalid).
+					Errorf("consent of collection creator %v: %v", creator, err)), nil
+		}
+	}
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(fact.Source(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateNF).Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Source())), nil
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateInvalid).Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Source())), nil
+	}
+
+	if !nv.Active() {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("burned nft idx %v in contract account %v", fact.NFT(), fact.Source())), nil
+	}
+
+	// NOTE locked nft is owned by the source contract account until it is claimed.
+	if !nv.Owner().Equal(fact.Sender()) {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not owner of nft idx %v in contract account %v", fact.Sender(), fact.NFT(), fact.Source())), nil
+	}
+
+	if err := checkRestriction(fact.Target(), nv.Owner(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := policy.URIPolicy().Permits(nv.URI()); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrValueInvalid).
+				Errorf("uri of nft idx %v for contract account %v: %v", fact.NFT(), fact.Target(), err)), nil
+	}
+
+	if policy.UniqueHash() && nv.NFTHash() != "" {
+		if found, _ := state.CheckNotExistsState(
+			statenft.StateKeyNFTHash(fact.Target(), nv.NFTHash()), getStateFunc); found {
+			return ctx, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMStateE).
+					Errorf("nft hash %v already exists in contract account %v", nv.NFTHash(), fact.Target())), nil
+		}
+	}
+
+	if err := state.CheckExistsState(statenft.NFTStateKey(fact.Target(), statenft.LastIDXKey), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateNF).Errorf("collection last index, %v: %v", fact.Target(), err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+// Process burns nft in the source collection and mints it with the same
+// owner, hash, uri, creators and attributes at the next index of the target
+// collection; the new nft keeps the source as its provenance.
+func (opp *MigrateProcessor) Process(
+	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process Migrate")
+	fact, ok := op.Fact().(MigrateFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected MigrateFact, not %T", op.Fact())
+	}
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(fact.Source(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("nft not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("nft value not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	policy, err := collectionPolicy(fact.Target(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("collection policy not found, %v: %w", fact.Target(), err), nil
+	}
+
+	idxKey := statenft.NFTStateKey(fact.Target(), statenft.LastIDXKey)
+	st, err = state.ExistsState(idxKey, "collection index", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("collection last index state not found, %v: %w", fact.Target(), err), nil
+	}
+
+	idx, err := statenft.StateLastNFTIndexValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("collection last index value not found, %v: %w", fact.Target(), err), nil
+	}
+
+	burned := types.NewNFT(
+		nv.ID(), false, nv.Owner(), nv.NFTHash(), nv.URI(), nv.Owner(), nv.Creators(), nv.Attributes(), nv.Provenance())
+	if err := burned.IsValid(nil); err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("invalid nft, %v: %w", fact.NFT(), err), nil
+	}
+
+	n := types.NewNFT(
+		idx, true, nv.Owner(), nv.NFTHash(), nv.URI(), nv.Owner(), nv.Creators(), nv.Attributes(),
+		types.NewProvenance(fact.Source(), fact.NFT()),
+	)
+	if err := n.IsValid(nil); err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("invalid nft, %v: %w", idx, err), nil
+	}
+
+	var sts []mitumbase.StateMergeValue
+	sts = append(sts,
+		state.NewStateMergeValue(statenft.StateKeyNFT(fact.Source(), fact.NFT()), statenft.NewNFTStateValue(burned)),
+		state.NewStateMergeValue(statenft.StateKeyNFT(fact.Target(), idx), statenft.NewNFTStateValue(n)),
+		state.NewStateMergeValue(idxKey, statenft.NewLastNFTIndexStateValue(idx+1)),
+	)
+
+	for _, c := range []struct {
+		contract mitumbase.Address
+		delta    int64
+	}{{fact.Source(), -1}, {fact.Target(), 1}} {
+		smv, err := supplyStateMergeValue(c.contract, c.delta, getStateFunc)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("collection supply, %v: %w", c.contract, err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	if policy.UniqueHash() && n.NFTHash() != "" {
+		sts = append(sts, state.NewStateMergeValue(
+			statenft.StateKeyNFTHash(fact.Target(), n.NFTHash()),
+			statenft.NewNFTHashStateValue(n.NFTHash(), idx),
+		))
+	}
+
+	feeSts, err := payCollectionItemsFee(fact.Sender(), []CollectionItem{fact}, getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *MigrateProcessor) Close() error {
+	migrateProcessorPool.Put(opp)
+
+	return nil
+}
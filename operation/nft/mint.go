@@ -12,22 +12,26 @@ import (
 var MaxMintItems = 100
 
 var (
-	MintFactHint = hint.MustNewHint("mitum-nft-mint-operation-fact-v0.0.1")
+	MintFactHint = hint.MustNewHint("mitum-nft-mint-operation-fact-v0.0.2")
 	MintHint     = hint.MustNewHint("mitum-nft-mint-operation-v0.0.1")
+	// LegacyMintFactHint is the mint fact before fee payer; it is decoded into MintFact without fee payer.
+	LegacyMintFactHint = hint.MustNewHint("mitum-nft-mint-operation-fact-v0.0.1")
 )
 
 type MintFact struct {
 	mitumbase.BaseFact
-	sender mitumbase.Address
-	items  []MintItem
+	sender   mitumbase.Address
+	items    []MintItem
+	feePayer mitumbase.Address
 }
 
-func NewMintFact(token []byte, sender mitumbase.Address, items []MintItem) MintFact {
+func NewMintFact(token []byte, sender mitumbase.Address, items []MintItem, feePayer mitumbase.Address) MintFact {
 	bf := mitumbase.NewBaseFact(MintFactHint, token)
 	fact := MintFact{
 		BaseFact: bf,
 		sender:   sender,
 		items:    items,
+		feePayer: feePayer,
 	}
 	fact.SetHash(fact.GenerateHash())
 	return fact
@@ -58,6 +62,22 @@ func (fact MintFact) IsValid(b []byte) error {
 		}
 	}
 
+	if fact.feePayer != nil {
+		if fact.Hint().Equal(LegacyMintFactHint) {
+			return common.ErrFactInvalid.Wrap(
+				errors.Errorf("fee payer not allowed in %v", LegacyMintFactHint))
+		}
+
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("fee payer %v is same with sender", fact.feePayer)))
+		}
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -80,10 +100,16 @@ func (fact MintFact) Bytes() []byte {
 		is[i] = fact.items[i].Bytes()
 	}
 
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(is...),
+		fp,
 	)
 }
 
@@ -95,6 +121,21 @@ func (fact MintFact) Sender() mitumbase.Address {
 	return fact.sender
 }
 
+// FeePayer returns the sponsor which pays the fee instead of sender; it is nil
+// when sender pays the fee.
+func (fact MintFact) FeePayer() mitumbase.Address {
+	return fact.feePayer
+}
+
+// Payer returns the account whose balance is charged for the fee of fact.
+func (fact MintFact) Payer() mitumbase.Address {
+	if fact.feePayer != nil {
+		return fact.feePayer
+	}
+
+	return fact.sender
+}
+
 func (fact MintFact) Addresses() ([]mitumbase.Address, error) {
 	as := []mitumbase.Address{}
 
@@ -108,6 +149,10 @@ func (fact MintFact) Addresses() ([]mitumbase.Address, error) {
 
 	as = append(as, fact.sender)
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
@@ -11,6 +11,7 @@ func (fact *MintFact) unpack(
 	enc encoder.Encoder,
 	sd string,
 	bits []byte,
+	fp string,
 ) error {
 	switch sender, err := base.DecodeAddress(sd, enc); {
 	case err != nil:
@@ -35,5 +36,11 @@ func (fact *MintFact) unpack(
 	}
 	fact.items = items
 
+	feePayer, err := base.DecodeAddress(fp, enc)
+	if err != nil {
+		return err
+	}
+	fact.feePayer = feePayer
+
 	return nil
 }
@@ -17,16 +17,21 @@ type CollectionItem interface {
 	Currency() currencytypes.CurrencyID
 }
 
-var MintItemHint = hint.MustNewHint("mitum-nft-mint-item-v0.0.1")
+var (
+	MintItemHint = hint.MustNewHint("mitum-nft-mint-item-v0.0.2")
+	// LegacyMintItemHint is the mint item before attributes; it is decoded into MintItem without attributes.
+	LegacyMintItemHint = hint.MustNewHint("mitum-nft-mint-item-v0.0.1")
+)
 
 type MintItem struct {
 	hint.BaseHinter
-	contract mitumbase.Address
-	receiver mitumbase.Address
-	hash     types.NFTHash
-	uri      types.URI
-	creators types.Signers
-	currency currencytypes.CurrencyID
+	contract   mitumbase.Address
+	receiver   mitumbase.Address
+	hash       types.NFTHash
+	uri        types.URI
+	creators   types.Signers
+	attributes []types.Attribute
+	currency   currencytypes.CurrencyID
 }
 
 func NewMintItem(
@@ -35,6 +40,7 @@ func NewMintItem(
 	hash types.NFTHash,
 	uri types.URI,
 	creators types.Signers,
+	attributes []types.Attribute,
 	currency currencytypes.CurrencyID,
 ) MintItem {
 	return MintItem{
@@ -44,6 +50,7 @@ func NewMintItem(
 		hash:       hash,
 		uri:        uri,
 		creators:   creators,
+		attributes: attributes,
 		currency:   currency,
 	}
 }
@@ -55,6 +62,7 @@ func (it MintItem) Bytes() []byte {
 		it.hash.Bytes(),
 		it.uri.Bytes(),
 		it.creators.Bytes(),
+		types.AttributesBytes(it.attributes),
 		it.currency.Bytes(),
 	)
 }
@@ -75,6 +83,18 @@ func (it MintItem) IsValid([]byte) error {
 		}
 	}
 
+	if err := it.hash.IsValidTyped(); err != nil {
+		return common.ErrValueInvalid.Wrap(err)
+	}
+
+	if it.Hint().Equal(LegacyMintItemHint) && len(it.attributes) > 0 {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("attributes not allowed in %v", LegacyMintItemHint))
+	}
+
+	if err := types.IsValidAttributes(it.attributes); err != nil {
+		return common.ErrValueInvalid.Wrap(err)
+	}
+
 	return util.CheckIsValiders(
 		nil,
 		false,
@@ -108,6 +128,10 @@ func (it MintItem) Creators() types.Signers {
 	return it.creators
 }
 
+func (it MintItem) Attributes() []types.Attribute {
+	return it.attributes
+}
+
 func (it MintItem) Addresses() ([]mitumbase.Address, error) {
 	as := []mitumbase.Address{}
 	as = append(as, it.receiver)
@@ -11,25 +11,27 @@ import (
 func (it MintItem) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":    it.Hint().String(),
-			"contract": it.contract,
-			"receiver": it.receiver,
-			"hash":     it.hash,
-			"uri":      it.uri,
-			"creators": it.creators,
-			"currency": it.currency,
+			"_hint":      it.Hint().String(),
+			"contract":   it.contract,
+			"receiver":   it.receiver,
+			"hash":       it.hash,
+			"uri":        it.uri,
+			"creators":   it.creators,
+			"attributes": it.attributes,
+			"currency":   it.currency,
 		},
 	)
 }
 
 type MintItemBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	Contract string   `bson:"contract"`
-	Receiver string   `bson:"receiver"`
-	Hash     string   `bson:"hash"`
-	Uri      string   `bson:"uri"`
-	Creators bson.Raw `bson:"creators"`
-	Currency string   `bson:"currency"`
+	Hint       string   `bson:"_hint"`
+	Contract   string   `bson:"contract"`
+	Receiver   string   `bson:"receiver"`
+	Hash       string   `bson:"hash"`
+	Uri        string   `bson:"uri"`
+	Creators   bson.Raw `bson:"creators"`
+	Attributes bson.Raw `bson:"attributes"`
+	Currency   string   `bson:"currency"`
 }
 
 func (it *MintItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -43,7 +45,7 @@ func (it *MintItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return common.DecorateError(err, common.ErrDecodeBson, *it)
 	}
 
-	if err := it.unpack(enc, ht, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Currency); err != nil {
+	if err := it.unpack(enc, ht, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Attributes, u.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *it)
 	}
 	return nil
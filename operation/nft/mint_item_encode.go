@@ -15,7 +15,7 @@ func (it *MintItem) unpack(
 	enc encoder.Encoder,
 	ht hint.Hint,
 	ca, ra, hs, uri string,
-	bcr []byte,
+	bcr, bat []byte,
 	cid string,
 ) error {
 	it.BaseHinter = hint.NewBaseHinter(ht)
@@ -44,6 +44,12 @@ func (it *MintItem) unpack(
 		it.creators = creators
 	}
 
+	attributes, err := types.DecodeAttributes(enc, bat)
+	if err != nil {
+		return err
+	}
+	it.attributes = attributes
+
 	it.currency = currencytypes.CurrencyID(cid)
 
 	return nil
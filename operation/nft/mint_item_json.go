@@ -14,12 +14,13 @@ import (
 
 type MintItemJSONMarshaler struct {
 	hint.BaseHinter
-	Contract mitumbase.Address        `json:"contract"`
-	Receiver mitumbase.Address        `json:"receiver"`
-	Hash     types.NFTHash            `json:"hash"`
-	Uri      types.URI                `json:"uri"`
-	Creators types.Signers            `json:"creators"`
-	Currency currencytypes.CurrencyID `json:"currency"`
+	Contract   mitumbase.Address        `json:"contract"`
+	Receiver   mitumbase.Address        `json:"receiver"`
+	Hash       types.NFTHash            `json:"hash"`
+	Uri        types.URI                `json:"uri"`
+	Creators   types.Signers            `json:"creators"`
+	Attributes []types.Attribute        `json:"attributes,omitempty"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
 }
 
 func (it MintItem) MarshalJSON() ([]byte, error) {
@@ -30,18 +31,20 @@ func (it MintItem) MarshalJSON() ([]byte, error) {
 		Hash:       it.hash,
 		Uri:        it.uri,
 		Creators:   it.creators,
+		Attributes: it.attributes,
 		Currency:   it.currency,
 	})
 }
 
 type MintItemJSONUnmarshaler struct {
-	Hint     hint.Hint       `json:"_hint"`
-	Contract string          `json:"contract"`
-	Receiver string          `json:"receiver"`
-	Hash     string          `json:"hash"`
-	Uri      string          `json:"uri"`
-	Creators json.RawMessage `json:"creators"`
-	Currency string          `json:"currency"`
+	Hint       hint.Hint       `json:"_hint"`
+	Contract   string          `json:"contract"`
+	Receiver   string          `json:"receiver"`
+	Hash       string          `json:"hash"`
+	Uri        string          `json:"uri"`
+	Creators   json.RawMessage `json:"creators"`
+	Attributes json.RawMessage `json:"attributes"`
+	Currency   string          `json:"currency"`
 }
 
 func (it *MintItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -50,7 +53,7 @@ func (it *MintItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return common.DecorateError(err, common.ErrDecodeJson, *it)
 	}
 
-	if err := it.unpack(enc, u.Hint, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Currency); err != nil {
+	if err := it.unpack(enc, u.Hint, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Attributes, u.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *it)
 	}
 
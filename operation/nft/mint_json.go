@@ -11,8 +11,9 @@ import (
 
 type MintFactJSONMarshaler struct {
 	mitumbase.BaseFactJSONMarshaler
-	Sender mitumbase.Address `json:"sender"`
-	Items  []MintItem        `json:"items"`
+	Sender   mitumbase.Address `json:"sender"`
+	Items    []MintItem        `json:"items"`
+	FeePayer mitumbase.Address `json:"fee_payer,omitempty"`
 }
 
 func (fact MintFact) MarshalJSON() ([]byte, error) {
@@ -20,13 +21,15 @@ func (fact MintFact) MarshalJSON() ([]byte, error) {
 		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
 		Sender:                fact.sender,
 		Items:                 fact.items,
+		FeePayer:              fact.feePayer,
 	})
 }
 
 type MintFactJSONUnmarshaler struct {
 	mitumbase.BaseFactJSONUnmarshaler
-	Sender string          `json:"sender"`
-	Items  json.RawMessage `json:"items"`
+	Sender   string          `json:"sender"`
+	Items    json.RawMessage `json:"items"`
+	FeePayer string          `json:"fee_payer,omitempty"`
 }
 
 func (fact *MintFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -37,7 +40,7 @@ func (fact *MintFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
 
-	if err := fact.unpack(enc, u.Sender, u.Items); err != nil {
+	if err := fact.unpack(enc, u.Sender, u.Items, u.FeePayer); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
 	}
 
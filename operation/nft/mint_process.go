@@ -8,7 +8,6 @@ import (
 	statenft "github.com/ProtoconNet/mitum-nft/state"
 	"github.com/ProtoconNet/mitum-nft/types"
 
-	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	stateextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
@@ -37,11 +36,13 @@ func (Mint) Process(
 }
 
 type MintItemProcessor struct {
-	h      util.Hash
-	sender base.Address
-	item   MintItem
-	idx    uint64
-	ns     map[string]base.StateMergeValue
+	h          util.Hash
+	sender     base.Address
+	item       MintItem
+	idx        uint64
+	uriPolicy  types.URIPolicy
+	uniqueHash bool
+	ns         map[string]base.StateMergeValue
 }
 
 func (ipp *MintItemProcessor) PreProcess(
@@ -60,6 +61,10 @@ func (ipp *MintItemProcessor) PreProcess(
 			errors.Errorf("%v: receiver %v is contract account", cErr, ipp.item.Receiver())))
 	}
 
+	if err := checkRestriction(ipp.item.Contract(), ipp.item.Receiver(), getStateFunc); err != nil {
+		return e.Wrap(err)
+	}
+
 	if found, _ := currencystate.CheckNotExistsState(
 		statenft.StateKeyNFT(ipp.item.Contract(), ipp.idx), getStateFunc); found {
 		return e.Wrap(
@@ -67,6 +72,20 @@ func (ipp *MintItemProcessor) PreProcess(
 				errors.Errorf("nft idx %v already exists in contract account %v", ipp.idx, ipp.item.Contract())))
 	}
 
+	if ipp.uniqueHash && ipp.item.NFTHash() != "" {
+		if found, _ := currencystate.CheckNotExistsState(
+			statenft.StateKeyNFTHash(ipp.item.Contract(), ipp.item.NFTHash()), getStateFunc); found {
+			return e.Wrap(
+				common.ErrStateE.Wrap(
+					errors.Errorf("nft hash %v already exists in contract account %v", ipp.item.NFTHash(), ipp.item.Contract())))
+		}
+	}
+
+	if _, err := ipp.uriPolicy.Resolve(ipp.item.URI(), ipp.idx); err != nil {
+		return e.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("uri of nft idx %v in contract account %v: %v", ipp.idx, ipp.item.Contract(), err)))
+	}
+
 	creators := ipp.item.Creators().Signers()
 	for _, creator := range creators {
 		acc := creator.Address()
@@ -105,13 +124,25 @@ func (ipp *MintItemProcessor) Process(
 		}
 	}
 
-	n := types.NewNFT(ipp.idx, true, ipp.item.Receiver(), ipp.item.NFTHash(), ipp.item.URI(), ipp.item.Receiver(), ipp.item.Creators())
+	uri, err := ipp.uriPolicy.Resolve(ipp.item.URI(), ipp.idx)
+	if err != nil {
+		return nil, errors.Errorf("invalid nft uri, %v: %v", ipp.idx, err)
+	}
+
+	n := types.NewNFT(ipp.idx, true, ipp.item.Receiver(), ipp.item.NFTHash(), uri, ipp.item.Receiver(), ipp.item.Creators(), ipp.item.Attributes(), types.Provenance{})
 	if err := n.IsValid(nil); err != nil {
 		return nil, errors.Errorf("invalid nft, %v: %v", ipp.idx, err)
 	}
 
 	sts = append(sts, currencystate.NewStateMergeValue(statenft.StateKeyNFT(ipp.item.Contract(), ipp.idx), statenft.NewNFTStateValue(n)))
 
+	if ipp.uniqueHash && ipp.item.NFTHash() != "" {
+		sts = append(sts, currencystate.NewStateMergeValue(
+			statenft.StateKeyNFTHash(ipp.item.Contract(), ipp.item.NFTHash()),
+			statenft.NewNFTHashStateValue(ipp.item.NFTHash(), ipp.idx),
+		))
+	}
+
 	return sts, nil
 }
 
@@ -120,6 +151,8 @@ func (ipp *MintItemProcessor) Close() {
 	ipp.sender = nil
 	ipp.item = MintItem{}
 	ipp.idx = 0
+	ipp.uriPolicy = types.URIPolicy{}
+	ipp.uniqueHash = false
 	//ipp.box = nil
 	ipp.ns = nil
 
@@ -162,6 +195,8 @@ func NewMintProcessor() currencytypes.GetNewProcessor {
 func (opp *MintProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(MintFact)
 	if !ok {
 		return ctx, base.NewBaseOperationProcessReasonError(
@@ -193,7 +228,20 @@ func (opp *MintProcessor) PreProcess(
 				Errorf("%v", err)), nil
 	}
 
+	if fact.FeePayer() != nil {
+		contracts := make([]base.Address, len(fact.Items()))
+		for i, item := range fact.Items() {
+			contracts[i] = item.Contract()
+		}
+
+		if err := checkFeePayer(fact.FeePayer(), contracts, op.Signs(), getStateFunc); err != nil {
+			return ctx, err, nil
+		}
+	}
+
 	idxes := map[string]uint64{}
+	uriPolicies := map[string]types.URIPolicy{}
+	uniqueHashes := map[string]bool{}
 	for _, item := range fact.Items() {
 		if _, found := idxes[item.contract.String()]; !found {
 			_, _, aErr, cErr := currencystate.ExistsCAccount(
@@ -258,7 +306,14 @@ func (opp *MintProcessor) PreProcess(
 					common.ErrMPreProcess.
 						Errorf("%v", err)), nil
 			}
-			if !ca.Owner().Equal(fact.Sender()) {
+			granted, err := hasRole(item.Contract(), fact.Sender(), getStateFunc, types.RoleAdmin, types.RoleMinter)
+			if err != nil {
+				return ctx, base.NewBaseOperationProcessReasonError(
+					common.ErrMPreProcess.
+						Errorf("%v", err)), nil
+			}
+
+			if !ca.Owner().Equal(fact.Sender()) && !granted {
 				for i := range whitelist {
 					if whitelist[i].Equal(fact.Sender()) {
 						break
@@ -267,7 +322,7 @@ func (opp *MintProcessor) PreProcess(
 						return ctx, base.NewBaseOperationProcessReasonError(
 							common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
 								Errorf(
-									"sender %v is neither the owner nor in the minter whitelist of contract account %v",
+									"sender %v is neither the owner, a granted minter nor in the minter whitelist of contract account %v",
 									fact.Sender(), item.Contract())), nil
 					}
 				}
@@ -294,9 +349,26 @@ func (opp *MintProcessor) PreProcess(
 			}
 
 			idxes[item.contract.String()] = nftID
+			uriPolicies[item.contract.String()] = policy.URIPolicy()
+			uniqueHashes[item.contract.String()] = policy.UniqueHash()
+		}
+	}
+
+	var uniqueItems []MintItem
+	for _, item := range fact.Items() {
+		if uniqueHashes[item.contract.String()] && item.NFTHash() != "" {
+			uniqueItems = append(uniqueItems, item)
 		}
 	}
 
+	hctx, err := checkNFTHashesInBlock(ctx, uniqueItems)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrDupVal).
+				Errorf("%v", err)), nil
+	}
+
 	for _, item := range fact.Items() {
 		ip := mintItemProcessorPool.Get()
 		ipc, ok := ip.(*MintItemProcessor)
@@ -309,6 +381,8 @@ func (opp *MintProcessor) PreProcess(
 		ipc.sender = fact.Sender()
 		ipc.item = item
 		ipc.idx = idxes[item.contract.String()]
+		ipc.uriPolicy = uriPolicies[item.contract.String()]
+		ipc.uniqueHash = uniqueHashes[item.contract.String()]
 		//ipc.box = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
@@ -321,13 +395,61 @@ func (opp *MintProcessor) PreProcess(
 		ipc.Close()
 	}
 
-	return ctx, nil, nil
+	if fact.FeePayer() != nil {
+		items := make([]CollectionItem, len(fact.Items()))
+		for i := range fact.Items() {
+			items[i] = fact.Items()[i]
+		}
+
+		nctx, err := checkSponsoredFee(ctx, fact.FeePayer(), items, getStateFunc)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err)), nil
+		}
+		hctx = nctx
+	}
+
+	return hctx, nil, nil
+}
+
+// mintedHashesContextKey keeps the unique nft hashes minted by the operations
+// preprocessed before in the same block.
+type mintedHashesContextKey struct{}
+
+// checkNFTHashesInBlock checks that the hashes of items are neither repeated in
+// items nor minted yet in the same block; the hashes are checked against the
+// state before the block, so the later nft would take over the hash of the
+// former one. It returns the context which carries the minted hashes.
+func checkNFTHashesInBlock(ctx context.Context, items []MintItem) (context.Context, error) {
+	if len(items) < 1 {
+		return ctx, nil
+	}
+
+	prev, _ := ctx.Value(mintedHashesContextKey{}).(map[string]struct{})
+
+	minted := make(map[string]struct{}, len(prev)+len(items))
+	for k := range prev {
+		minted[k] = struct{}{}
+	}
+
+	for _, item := range items {
+		k := statenft.StateKeyNFTHash(item.Contract(), item.NFTHash())
+		if _, found := minted[k]; found {
+			return ctx, errors.Errorf(
+				"nft hash %v for contract account %v already minted in this operation or block", item.NFTHash(), item.Contract())
+		}
+		minted[k] = struct{}{}
+	}
+
+	return context.WithValue(ctx, mintedHashesContextKey{}, minted), nil
 }
 
 func (opp *MintProcessor) Process( // nolint:dupl
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Mint")
 
 	fact, ok := op.Fact().(MintFact)
@@ -336,11 +458,20 @@ func (opp *MintProcessor) Process( // nolint:dupl
 	}
 
 	idxes := map[string]uint64{}
+	uriPolicies := map[string]types.URIPolicy{}
+	uniqueHashes := map[string]bool{}
 	//boxes := map[string]*types.NFTBox{}
 
 	for _, item := range fact.items {
 		idxKey := statenft.NFTStateKey(item.contract, statenft.LastIDXKey)
 		if _, found := idxes[idxKey]; !found {
+			policy, err := collectionPolicy(item.contract, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection policy not found, %v: %w", item.contract, err), nil
+			}
+			uriPolicies[idxKey] = policy.URIPolicy()
+			uniqueHashes[idxKey] = policy.UniqueHash()
+
 			st, err := currencystate.ExistsState(idxKey, "collection index", getStateFunc)
 			if err != nil {
 				return nil, base.NewBaseOperationProcessReasonError("collection last index state not found, %v: %w", item.contract, err), nil
@@ -371,6 +502,8 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		ipc.sender = fact.Sender()
 		ipc.item = item
 		ipc.idx = idxes[idxKey]
+		ipc.uriPolicy = uriPolicies[idxKey]
+		ipc.uniqueHash = uniqueHashes[idxKey]
 		ipc.ns = nsts
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
@@ -388,6 +521,22 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		sts = append(sts, iv)
 	}
 
+	minted := map[string]int64{}
+	contracts := map[string]base.Address{}
+	for _, item := range fact.Items() {
+		minted[item.contract.String()] += 1
+		contracts[item.contract.String()] = item.contract
+	}
+
+	for key, n := range minted {
+		smv, err := supplyStateMergeValue(contracts[key], n, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("collection supply, %v: %w", key, err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
 	for _, ns := range nsts {
 		sts = append(sts, ns)
 	}
@@ -404,50 +553,11 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		items[i] = fact.Items()[i]
 	}
 
-	feeReceiverBalSts, required, err := CalculateCollectionItemsFee(getStateFunc, items)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
-	}
-	sb, err := currency.CheckEnoughBalance(fact.Sender(), required, getStateFunc)
+	feeSts, err := payCollectionItemsFee(fact.Payer(), items, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
-	}
-
-	for cid := range sb {
-		v, ok := sb[cid].Value().(statecurrency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Errorf("expected BalanceStateValue, not %T", sb[cid].Value())
-		}
-
-		_, feeReceiverFound := feeReceiverBalSts[cid]
-
-		if feeReceiverFound && (sb[cid].Key() != feeReceiverBalSts[cid].Key()) {
-			stmv := common.NewBaseStateMergeValue(
-				sb[cid].Key(),
-				statecurrency.NewDeductBalanceStateValue(v.Amount.WithBig(required[cid][1])),
-				func(height base.Height, st base.State) base.StateValueMerger {
-					return statecurrency.NewBalanceStateValueMerger(height, sb[cid].Key(), cid, st)
-				},
-			)
-
-			r, ok := feeReceiverBalSts[cid].Value().(statecurrency.BalanceStateValue)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected %T, not %T", statecurrency.BalanceStateValue{}, feeReceiverBalSts[cid].Value()), nil
-			}
-			sts = append(
-				sts,
-				common.NewBaseStateMergeValue(
-					feeReceiverBalSts[cid].Key(),
-					statecurrency.NewAddBalanceStateValue(r.Amount.WithBig(required[cid][1])),
-					func(height base.Height, st base.State) base.StateValueMerger {
-						return statecurrency.NewBalanceStateValueMerger(height, feeReceiverBalSts[cid].Key(), cid, st)
-					},
-				),
-			)
-
-			sts = append(sts, stmv)
-		}
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
 	}
+	sts = append(sts, feeSts...)
 
 	return sts, nil, nil
 }
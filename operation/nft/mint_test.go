@@ -0,0 +1,102 @@
+package nft
+
+import (
+	"context"
+	"testing"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+)
+
+func newTestMintFact(feePayer mitumbase.Address) MintFact {
+	item := NewMintItem(
+		mitumbase.NewStringAddress("contract"),
+		mitumbase.NewStringAddress("receiver"),
+		types.NFTHash("nfthash"),
+		types.URI("https://localhost:5000/nft"),
+		types.NewSigners(nil),
+		nil,
+		currencytypes.CurrencyID("MCC"),
+	)
+
+	return NewMintFact([]byte("token"), mitumbase.NewStringAddress("sender"), []MintItem{item}, feePayer)
+}
+
+func TestMintFactFeePayer(t *testing.T) {
+	if err := newTestMintFact(nil).IsValid(nil); err != nil {
+		t.Errorf("without fee payer: unexpected error, %v", err)
+	}
+
+	if err := newTestMintFact(mitumbase.NewStringAddress("payer")).IsValid(nil); err != nil {
+		t.Errorf("with fee payer: unexpected error, %v", err)
+	}
+
+	if err := newTestMintFact(mitumbase.NewStringAddress("sender")).IsValid(nil); err == nil {
+		t.Error("sender as fee payer: expected error")
+	}
+}
+
+func TestMintFactLegacyFeePayer(t *testing.T) {
+	legacy := func(feePayer mitumbase.Address) MintFact {
+		fact := newTestMintFact(feePayer)
+		fact.BaseFact = mitumbase.NewBaseFact(LegacyMintFactHint, fact.Token())
+		fact.SetHash(fact.GenerateHash())
+
+		return fact
+	}
+
+	if err := legacy(nil).IsValid(nil); err != nil {
+		t.Errorf("legacy without fee payer: unexpected error, %v", err)
+	}
+
+	// NOTE the legacy fact is decoded without fee payer, so the fee payer can
+	// not be signed in it.
+	if err := legacy(mitumbase.NewStringAddress("payer")).IsValid(nil); err == nil {
+		t.Error("legacy with fee payer: expected error")
+	}
+}
+
+func TestCheckNFTHashesInBlock(t *testing.T) {
+	newItem := func(contract string, hash types.NFTHash) MintItem {
+		return NewMintItem(
+			mitumbase.NewStringAddress(contract),
+			mitumbase.NewStringAddress("receiver"),
+			hash,
+			types.URI("https://localhost:5000/nft"),
+			types.NewSigners(nil),
+			nil,
+			currencytypes.CurrencyID("MCC"),
+		)
+	}
+
+	ctx, err := checkNFTHashesInBlock(context.Background(), []MintItem{newItem("contract0", "a")})
+	if err != nil {
+		t.Fatalf("first mint: unexpected error, %v", err)
+	}
+
+	// NOTE both mints pass the check against the state before the block, so the
+	// hash index of the later one would overwrite the former.
+	if _, err := checkNFTHashesInBlock(ctx, []MintItem{newItem("contract0", "a")}); err == nil {
+		t.Error("same hash in block: expected error")
+	}
+
+	if _, err := checkNFTHashesInBlock(context.Background(), []MintItem{
+		newItem("contract0", "b"), newItem("contract0", "b"),
+	}); err == nil {
+		t.Error("same hash in operation: expected error")
+	}
+
+	nctx, err := checkNFTHashesInBlock(ctx, []MintItem{newItem("contract1", "a"), newItem("contract0", "b")})
+	if err != nil {
+		t.Fatalf("same hash of other contract: unexpected error, %v", err)
+	}
+
+	if _, err := checkNFTHashesInBlock(nctx, []MintItem{newItem("contract0", "b")}); err == nil {
+		t.Error("hash after other mint: expected error")
+	}
+
+	if _, err := checkNFTHashesInBlock(context.Background(), []MintItem{newItem("contract0", "a")}); err != nil {
+		t.Errorf("mint in next block: unexpected error, %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// checkNotGoverned checks that the collection of contract has no council; once
+// council is registered, the administration of collection should be proposed
+// to council instead of being updated directly.
+func checkNotGoverned(contract mitumbase.Address, getStateFunc mitumbase.GetStateFunc) error {
+	if found, _ := state.CheckNotExistsState(statenft.NFTStateKey(contract, statenft.CouncilKey), getStateFunc); found {
+		return common.ErrAccountNAth.Wrap(
+			errors.Errorf("collection of contract account %v is governed by council, propose it instead", contract))
+	}
+
+	return nil
+}
+
+// proposalActionStates returns the state merge values which apply action to the
+// collection of contract; a role already granted or revoked is left as it is.
+func proposalActionStates(
+	contract mitumbase.Address, action types.ProposalAction, getStateFunc mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, error) {
+	switch action.Kind() {
+	case types.ProposalActionRestrictionMode:
+		return []mitumbase.StateMergeValue{state.NewStateMergeValue(
+			statenft.NFTStateKey(contract, statenft.RestrictionModeKey),
+			statenft.NewRestrictionModeStateValue(action.Mode()),
+		)}, nil
+	case types.ProposalActionRelayers:
+		if action.Relayers() == nil {
+			return nil, errors.Errorf("empty relayers of proposal action")
+		}
+
+		return []mitumbase.StateMergeValue{state.NewStateMergeValue(
+			statenft.NFTStateKey(contract, statenft.RelayersKey),
+			statenft.NewRelayerSetStateValue(*action.Relayers()),
+		)}, nil
+	}
+
+	var sts []mitumbase.StateMergeValue
+
+	for _, acc := range action.Accounts() {
+		switch action.Kind() {
+		case types.ProposalActionGrantRole, types.ProposalActionRevokeRole:
+			roles, err := rolesOf(contract, acc, getStateFunc)
+			if err != nil {
+				return nil, err
+			}
+
+			var nroles []types.Role
+			for _, r := range roles {
+				if r != action.Role() {
+					nroles = append(nroles, r)
+				}
+			}
+
+			if action.Kind() == types.ProposalActionGrantRole {
+				smv, err := state.CreateNotExistAccount(acc, getStateFunc)
+				if err != nil {
+					return nil, err
+				} else if smv != nil {
+					sts = append(sts, smv)
+				}
+
+				nroles = append(nroles, action.Role())
+			}
+
+			sts = append(sts, state.NewStateMergeValue(
+				statenft.StateKeyRoles(contract, acc),
+				statenft.NewRolesStateValue(nroles),
+			))
+		case types.ProposalActionAddSponsors, types.ProposalActionRemoveSponsors:
+			sts = append(sts, state.NewStateMergeValue(
+				statenft.StateKeySponsor(contract, acc),
+				statenft.NewSponsorStateValue(action.Kind() == types.ProposalActionAddSponsors),
+			))
+		case types.ProposalActionAddRestriction, types.ProposalActionRemoveRestriction:
+			sts = append(sts, state.NewStateMergeValue(
+				statenft.StateKeyRestriction(contract, acc),
+				statenft.NewRestrictionStateValue(action.Kind() == types.ProposalActionAddRestriction),
+			))
+		default:
+			return nil, errors.Errorf("unknown proposal action, %v", action.Kind())
+		}
+	}
+
+	return sts, nil
+}
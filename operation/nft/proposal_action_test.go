@@ -0,0 +1,216 @@
+package nft
+
+import (
+	"context"
+	"testing"
+
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+)
+
+func TestCheckNotGoverned(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	member := mitumbase.NewStringAddress("member")
+	councilKey := statenft.NFTStateKey(contract, statenft.CouncilKey)
+	council := statenft.NewCouncilStateValue(types.NewCouncil([]mitumbase.Address{member}, 1))
+
+	if err := checkNotGoverned(contract, newTestGetStateFunc()); err != nil {
+		t.Errorf("collection without council: unexpected error, %v", err)
+	}
+
+	if err := checkNotGoverned(contract, newTestGetStateFunc(newTestState(3, councilKey, council))); err == nil {
+		t.Error("collection with council: expected error")
+	}
+
+	// NOTE the council of the previous registration does not govern collection.
+	getStateFunc := registeredStateFunc(newTestGetStateFunc(
+		newTestState(5, statenft.NFTStateKey(contract, statenft.RegistrationKey), statenft.NewRegistrationStateValue(5)),
+		newTestState(3, councilKey, council),
+	))
+
+	if err := checkNotGoverned(contract, getStateFunc); err != nil {
+		t.Errorf("council of previous registration: unexpected error, %v", err)
+	}
+}
+
+func TestProposalActionStatesRevokeRole(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	account := mitumbase.NewStringAddress("account")
+	key := statenft.StateKeyRoles(contract, account)
+
+	getStateFunc := newTestGetStateFunc(
+		newTestState(3, key, statenft.NewRolesStateValue([]types.Role{types.RoleAdmin, types.RoleMinter})),
+	)
+
+	sts, err := proposalActionStates(
+		contract,
+		types.NewProposalAction(types.ProposalActionRevokeRole, types.RoleMinter, []mitumbase.Address{account}),
+		getStateFunc,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	if len(sts) != 1 || sts[0].Key() != key {
+		t.Fatalf("expected roles state of %q, not %v", key, sts)
+	}
+
+	v, ok := sts[0].Value().(statenft.RolesStateValue)
+	if !ok {
+		t.Fatalf("expected RolesStateValue, not %T", sts[0].Value())
+	}
+
+	if len(v.Roles) != 1 || v.Roles[0] != types.RoleAdmin {
+		t.Errorf("expected only admin role left, not %v", v.Roles)
+	}
+}
+
+func TestProposalActionStatesSponsorsAndRestriction(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	accounts := []mitumbase.Address{mitumbase.NewStringAddress("account0"), mitumbase.NewStringAddress("account1")}
+
+	for _, kind := range []types.ProposalActionKind{
+		types.ProposalActionAddSponsors,
+		types.ProposalActionRemoveSponsors,
+		types.ProposalActionAddRestriction,
+		types.ProposalActionRemoveRestriction,
+	} {
+		sts, err := proposalActionStates(contract, types.NewProposalAction(kind, "", accounts), newTestGetStateFunc())
+		if err != nil {
+			t.Fatalf("%v: unexpected error, %v", kind, err)
+		}
+
+		if len(sts) != len(accounts) {
+			t.Fatalf("%v: expected %d states, not %d", kind, len(accounts), len(sts))
+		}
+
+		for i := range sts {
+			switch v := sts[i].Value().(type) {
+			case statenft.SponsorStateValue:
+				if sts[i].Key() != statenft.StateKeySponsor(contract, accounts[i]) {
+					t.Errorf("%v: unexpected key, %q", kind, sts[i].Key())
+				}
+
+				if v.Allowed != (kind == types.ProposalActionAddSponsors) {
+					t.Errorf("%v: unexpected sponsor, %v", kind, v.Allowed)
+				}
+			case statenft.RestrictionStateValue:
+				if sts[i].Key() != statenft.StateKeyRestriction(contract, accounts[i]) {
+					t.Errorf("%v: unexpected key, %q", kind, sts[i].Key())
+				}
+
+				if v.Listed != (kind == types.ProposalActionAddRestriction) {
+					t.Errorf("%v: unexpected restriction, %v", kind, v.Listed)
+				}
+			default:
+				t.Errorf("%v: unexpected state value, %T", kind, v)
+			}
+		}
+	}
+}
+
+func TestCheckProposalApprovedInBlock(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	a := statenft.StateKeyProposal(contract, "a")
+	b := statenft.StateKeyProposal(contract, "b")
+
+	ctx, err := checkProposalApprovedInBlock(context.Background(), a)
+	if err != nil {
+		t.Fatalf("first approval: unexpected error, %v", err)
+	}
+
+	// NOTE the approvals of the other council members in the same block are
+	// rebuilt from the same state, so only the first one is accepted.
+	if _, err := checkProposalApprovedInBlock(ctx, a); err == nil {
+		t.Error("second approval in block: expected error")
+	}
+
+	nctx, err := checkProposalApprovedInBlock(ctx, b)
+	if err != nil {
+		t.Fatalf("approval of other proposal: unexpected error, %v", err)
+	}
+
+	if _, err := checkProposalApprovedInBlock(nctx, a); err == nil {
+		t.Error("approval after other proposal: expected error")
+	}
+
+	if _, err := checkProposalApprovedInBlock(context.Background(), a); err != nil {
+		t.Errorf("approval in next block: unexpected error, %v", err)
+	}
+}
+
+func TestProposalActionStatesRestrictionMode(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+
+	action := types.NewRestrictionModeProposalAction(types.RestrictionAllow)
+	if err := action.IsValid(nil); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	sts, err := proposalActionStates(contract, action, newTestGetStateFunc())
+	if err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	key := statenft.NFTStateKey(contract, statenft.RestrictionModeKey)
+	if len(sts) != 1 || sts[0].Key() != key {
+		t.Fatalf("expected restriction mode state of %q, not %v", key, sts)
+	}
+
+	if v, ok := sts[0].Value().(statenft.RestrictionModeStateValue); !ok || v.Mode != types.RestrictionAllow {
+		t.Errorf("expected allow restriction mode, not %v", sts[0].Value())
+	}
+
+	if err := types.NewRestrictionModeProposalAction(types.RestrictionMode("unknown")).IsValid(nil); err == nil {
+		t.Error("unknown restriction mode: expected error")
+	}
+}
+
+func TestProposalActionStatesRelayers(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	keys := []mitumbase.Publickey{mitumbase.NewMPrivatekey().Publickey(), mitumbase.NewMPrivatekey().Publickey()}
+
+	action := types.NewRelayersProposalAction(types.NewRelayerSet(keys, 2))
+	if err := action.IsValid(nil); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	sts, err := proposalActionStates(contract, action, newTestGetStateFunc())
+	if err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	key := statenft.NFTStateKey(contract, statenft.RelayersKey)
+	if len(sts) != 1 || sts[0].Key() != key {
+		t.Fatalf("expected relayers state of %q, not %v", key, sts)
+	}
+
+	v, ok := sts[0].Value().(statenft.RelayerSetStateValue)
+	if !ok {
+		t.Fatalf("expected RelayerSetStateValue, not %T", sts[0].Value())
+	}
+
+	if v.Relayers.Threshold() != 2 || len(v.Relayers.Keys()) != 2 {
+		t.Errorf("expected 2 of 2 relayers, not %d of %d", v.Relayers.Threshold(), len(v.Relayers.Keys()))
+	}
+
+	if err := types.NewRelayersProposalAction(types.NewRelayerSet(keys, 3)).IsValid(nil); err == nil {
+		t.Error("threshold over relayers: expected error")
+	}
+}
+
+func TestProposalActionCollectionKinds(t *testing.T) {
+	account := mitumbase.NewStringAddress("account")
+
+	// NOTE the restriction mode and relayers are of collection, not of accounts.
+	for _, kind := range []types.ProposalActionKind{types.ProposalActionRestrictionMode, types.ProposalActionRelayers} {
+		if err := types.NewProposalAction(kind, "", []mitumbase.Address{account}).IsValid(nil); err == nil {
+			t.Errorf("%v with accounts: expected error", kind)
+		}
+	}
+
+	if err := types.NewProposalAction(types.ProposalActionAddSponsors, "", nil).IsValid(nil); err == nil {
+		t.Error("account action without accounts: expected error")
+	}
+}
@@ -0,0 +1,143 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ProposeCollectionActionFactHint = hint.MustNewHint("mitum-nft-propose-collection-action-operation-fact-v0.0.1")
+	ProposeCollectionActionHint     = hint.MustNewHint("mitum-nft-propose-collection-action-operation-v0.0.1")
+)
+
+// ProposeCollectionActionFact proposes the change of roles, sponsors or
+// restriction list to council; once council is registered, they can not be
+// updated directly.
+type ProposeCollectionActionFact struct {
+	mitumbase.BaseFact
+	sender       mitumbase.Address
+	contract     mitumbase.Address
+	action       types.ProposalAction
+	expireHeight mitumbase.Height
+	currency     currencytypes.CurrencyID
+}
+
+func NewProposeCollectionActionFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	action types.ProposalAction,
+	expireHeight mitumbase.Height,
+	currency currencytypes.CurrencyID,
+) ProposeCollectionActionFact {
+	bf := mitumbase.NewBaseFact(ProposeCollectionActionFactHint, token)
+
+	fact := ProposeCollectionActionFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		action:       action,
+		expireHeight: expireHeight,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ProposeCollectionActionFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.action,
+		fact.expireHeight,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, acc := range fact.action.Accounts() {
+		if acc.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract", acc)))
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ProposeCollectionActionFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ProposeCollectionActionFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ProposeCollectionActionFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.action.Bytes(),
+		fact.expireHeight.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ProposeCollectionActionFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ProposeCollectionActionFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact ProposeCollectionActionFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact ProposeCollectionActionFact) Action() types.ProposalAction {
+	return fact.action
+}
+
+func (fact ProposeCollectionActionFact) ExpireHeight() mitumbase.Height {
+	return fact.expireHeight
+}
+
+func (fact ProposeCollectionActionFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ProposeCollectionActionFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type ProposeCollectionAction struct {
+	common.BaseOperation
+}
+
+func NewProposeCollectionAction(fact ProposeCollectionActionFact) (ProposeCollectionAction, error) {
+	return ProposeCollectionAction{BaseOperation: common.NewBaseOperation(ProposeCollectionActionHint, fact)}, nil
+}
@@ -0,0 +1,45 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *ProposeCollectionActionFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	bac []byte,
+	eh int64,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.expireHeight = mitumbase.Height(eh)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	switch a, err := mitumbase.DecodeAddress(ct, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	switch action, err := types.DecodeProposalAction(enc, bac); {
+	case err != nil:
+		return err
+	case action == nil:
+		return errors.Errorf("empty proposal action")
+	default:
+		fact.action = *action
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ProposeCollectionActionFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender       mitumbase.Address        `json:"sender"`
+	Contract     mitumbase.Address        `json:"contract"`
+	Action       types.ProposalAction     `json:"action"`
+	ExpireHeight mitumbase.Height         `json:"expire_height"`
+	Currency     currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact ProposeCollectionActionFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposeCollectionActionFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Action:                fact.action,
+		ExpireHeight:          fact.expireHeight,
+		Currency:              fact.currency,
+	})
+}
+
+type ProposeCollectionActionFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender       string          `json:"sender"`
+	Contract     string          `json:"contract"`
+	Action       json.RawMessage `json:"action"`
+	ExpireHeight int64           `json:"expire_height"`
+	Currency     string          `json:"currency"`
+}
+
+func (fact *ProposeCollectionActionFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ProposeCollectionActionFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Action, u.ExpireHeight, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ProposeCollectionActionMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ProposeCollectionAction) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposeCollectionActionMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ProposeCollectionAction) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
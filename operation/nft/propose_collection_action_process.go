@@ -0,0 +1,222 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var proposeCollectionActionProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ProposeCollectionActionProcessor)
+	},
+}
+
+func (ProposeCollectionAction) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ProposeCollectionActionProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewProposeCollectionActionProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new ProposeCollectionActionProcessor")
+
+		nopp := proposeCollectionActionProcessorPool.Get()
+		opp, ok := nopp.(*ProposeCollectionActionProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ProposeCollectionActionProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ProposeCollectionActionProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(ProposeCollectionActionFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ProposeCollectionActionFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v", cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	for _, acc := range fact.Action().Accounts() {
+		if _, _, _, cErr := state.ExistsCAccount(acc, "account", true, false, getStateFunc); cErr != nil {
+			return ctx, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: account %v is contract account", cErr, acc)), nil
+		}
+	}
+
+	_, _, aErr, cErr := state.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", cErr)), nil
+	}
+
+	st, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft collection state for contract account %v", fact.Contract())), nil
+
+	}
+
+	design, err := statenft.StateCollectionValue(st)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft collection state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("collection in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	cst, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CouncilKey), "council", getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("council for contract account %v", fact.Contract())), nil
+	}
+
+	council, err := statenft.StateCouncilValue(cst)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateInvalid).
+				Errorf("council for contract account %v", fact.Contract())), nil
+	}
+
+	if !council.IsMember(fact.Sender()) {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not council member of contract account %v", fact.Sender(), fact.Contract())), nil
+	}
+
+	if fact.ExpireHeight() <= opp.Height() {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("expire height %v must be greater than current height %v", fact.ExpireHeight(), opp.Height())), nil
+	}
+
+	if found, _ := state.CheckNotExistsState(
+		statenft.StateKeyProposal(fact.Contract(), fact.Hash().String()), getStateFunc); found {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("proposal %v for contract account %v", fact.Hash(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ProposeCollectionActionProcessor) Process(
+	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process ProposeCollectionAction")
+	fact, ok := op.Fact().(ProposeCollectionActionFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected ProposeCollectionActionFact, not %T", op.Fact())
+	}
+
+	action := fact.Action()
+
+	var sts []mitumbase.StateMergeValue
+	proposal := types.NewProposal(
+		fact.Hash().String(),
+		fact.Sender(),
+		types.CollectionPolicy{},
+		&action,
+		nil,
+		fact.ExpireHeight(),
+		types.ProposalPending,
+	)
+	sts = append(sts, state.NewStateMergeValue(
+		statenft.StateKeyProposal(fact.Contract(), proposal.ID()),
+		statenft.NewProposalStateValue(proposal),
+	))
+
+	feeSts, err := payCollectionItemsFee(fact.Sender(), []CollectionItem{fact}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *ProposeCollectionActionProcessor) Close() error {
+	proposeCollectionActionProcessorPool.Put(opp)
+
+	return nil
+}
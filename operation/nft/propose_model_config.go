@@ -0,0 +1,208 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ProposeModelConfigFactHint = hint.MustNewHint("mitum-nft-propose-model-config-operation-fact-v0.0.1")
+	ProposeModelConfigHint     = hint.MustNewHint("mitum-nft-propose-model-config-operation-v0.0.1")
+)
+
+type ProposeModelConfigFact struct {
+	mitumbase.BaseFact
+	sender       mitumbase.Address
+	contract     mitumbase.Address
+	name         types.CollectionName
+	royalty      types.PaymentParameter
+	uri          types.URI
+	whitelist    []mitumbase.Address
+	expireHeight mitumbase.Height
+	metadata     types.CollectionMetadata
+	uriPolicy    types.URIPolicy
+	currency     currencytypes.CurrencyID
+}
+
+func NewProposeModelConfigFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	name types.CollectionName,
+	royalty types.PaymentParameter,
+	uri types.URI,
+	whitelist []mitumbase.Address,
+	expireHeight mitumbase.Height,
+	metadata types.CollectionMetadata,
+	uriPolicy types.URIPolicy,
+	currency currencytypes.CurrencyID,
+) ProposeModelConfigFact {
+	bf := mitumbase.NewBaseFact(ProposeModelConfigFactHint, token)
+
+	fact := ProposeModelConfigFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		name:         name,
+		royalty:      royalty,
+		uri:          uri,
+		whitelist:    whitelist,
+		expireHeight: expireHeight,
+		metadata:     metadata,
+		uriPolicy:    uriPolicy,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ProposeModelConfigFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if l := len(fact.whitelist); l > types.MaxWhitelist {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("whitelist over allowed, %d > %d", l, types.MaxWhitelist)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.name,
+		fact.royalty,
+		fact.uri,
+		fact.metadata,
+		fact.uriPolicy,
+		fact.expireHeight,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, white := range fact.whitelist {
+		if err := white.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if white.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("whitelist account is same with contract")))
+		}
+
+		if _, found := founds[white.String()]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("whitelist account, %v", white)))
+		}
+		founds[white.String()] = struct{}{}
+	}
+
+	if err := fact.uriPolicy.PermitsAll(fact.uri, fact.metadata.Image(), fact.metadata.Banner()); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ProposeModelConfigFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ProposeModelConfigFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ProposeModelConfigFact) Bytes() []byte {
+	as := make([][]byte, len(fact.whitelist))
+	for i, white := range fact.whitelist {
+		as[i] = white.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.name.Bytes(),
+		fact.royalty.Bytes(),
+		fact.uri.Bytes(),
+		fact.currency.Bytes(),
+		util.ConcatBytesSlice(as...),
+		fact.expireHeight.Bytes(),
+		fact.metadata.Bytes(),
+		fact.uriPolicy.Bytes(),
+	)
+}
+
+func (fact ProposeModelConfigFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ProposeModelConfigFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact ProposeModelConfigFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact ProposeModelConfigFact) Name() types.CollectionName {
+	return fact.name
+}
+
+func (fact ProposeModelConfigFact) Royalty() types.PaymentParameter {
+	return fact.royalty
+}
+
+func (fact ProposeModelConfigFact) URI() types.URI {
+	return fact.uri
+}
+
+func (fact ProposeModelConfigFact) Whitelist() []mitumbase.Address {
+	return fact.whitelist
+}
+
+func (fact ProposeModelConfigFact) ExpireHeight() mitumbase.Height {
+	return fact.expireHeight
+}
+
+func (fact ProposeModelConfigFact) Metadata() types.CollectionMetadata {
+	return fact.metadata
+}
+
+func (fact ProposeModelConfigFact) URIPolicy() types.URIPolicy {
+	return fact.uriPolicy
+}
+
+func (fact ProposeModelConfigFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ProposeModelConfigFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type ProposeModelConfig struct {
+	common.BaseOperation
+}
+
+func NewProposeModelConfig(fact ProposeModelConfigFact) (ProposeModelConfig, error) {
+	return ProposeModelConfig{BaseOperation: common.NewBaseOperation(ProposeModelConfigHint, fact)}, nil
+}
@@ -0,0 +1,66 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ProposeModelConfigFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	nm string,
+	ry uint,
+	uri string,
+	bws []string,
+	eh int64,
+	bmd []byte,
+	bup []byte,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.expireHeight = mitumbase.Height(eh)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	fact.name = types.CollectionName(nm)
+	fact.royalty = types.PaymentParameter(ry)
+	fact.uri = types.URI(uri)
+
+	switch a, err := mitumbase.DecodeAddress(ct, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	whitelist := make([]mitumbase.Address, len(bws))
+	for i, bw := range bws {
+		white, err := mitumbase.DecodeAddress(bw, enc)
+		if err != nil {
+			return err
+		}
+		whitelist[i] = white
+	}
+	fact.whitelist = whitelist
+
+	metadata, err := types.DecodeCollectionMetadata(enc, bmd)
+	if err != nil {
+		return err
+	}
+	fact.metadata = metadata
+
+	uriPolicy, err := types.DecodeURIPolicy(enc, bup)
+	if err != nil {
+		return err
+	}
+	fact.uriPolicy = uriPolicy
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ProposeModelConfigFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender       mitumbase.Address        `json:"sender"`
+	Contract     mitumbase.Address        `json:"contract"`
+	Name         types.CollectionName     `json:"name"`
+	Royalty      types.PaymentParameter   `json:"royalty"`
+	URI          types.URI                `json:"uri"`
+	Whitelist    []mitumbase.Address      `json:"minter_whitelist"`
+	ExpireHeight mitumbase.Height         `json:"expire_height"`
+	Metadata     types.CollectionMetadata `json:"metadata"`
+	URIPolicy    types.URIPolicy          `json:"uri_policy"`
+	Currency     currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact ProposeModelConfigFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposeModelConfigFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Name:                  fact.name,
+		Royalty:               fact.royalty,
+		URI:                   fact.uri,
+		Whitelist:             fact.whitelist,
+		ExpireHeight:          fact.expireHeight,
+		Metadata:              fact.metadata,
+		URIPolicy:             fact.uriPolicy,
+		Currency:              fact.currency,
+	})
+}
+
+type ProposeModelConfigFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender       string          `json:"sender"`
+	Contract     string          `json:"contract"`
+	Name         string          `json:"name"`
+	Royalty      uint            `json:"royalty"`
+	URI          string          `json:"uri"`
+	Whitelist    []string        `json:"minter_whitelist"`
+	ExpireHeight int64           `json:"expire_height"`
+	Metadata     json.RawMessage `json:"metadata"`
+	URIPolicy    json.RawMessage `json:"uri_policy"`
+	Currency     string          `json:"currency"`
+}
+
+func (fact *ProposeModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ProposeModelConfigFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Name, u.Royalty, u.URI, u.Whitelist, u.ExpireHeight, u.Metadata, u.URIPolicy, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ProposeModelConfigMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ProposeModelConfig) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposeModelConfigMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ProposeModelConfig) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
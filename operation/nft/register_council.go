@@ -0,0 +1,147 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RegisterCouncilFactHint = hint.MustNewHint("mitum-nft-register-council-operation-fact-v0.0.1")
+	RegisterCouncilHint     = hint.MustNewHint("mitum-nft-register-council-operation-v0.0.1")
+)
+
+type RegisterCouncilFact struct {
+	mitumbase.BaseFact
+	sender    mitumbase.Address
+	contract  mitumbase.Address
+	members   []mitumbase.Address
+	threshold uint
+	currency  currencytypes.CurrencyID
+}
+
+func NewRegisterCouncilFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	members []mitumbase.Address,
+	threshold uint,
+	currency currencytypes.CurrencyID,
+) RegisterCouncilFact {
+	bf := mitumbase.NewBaseFact(RegisterCouncilFactHint, token)
+
+	fact := RegisterCouncilFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		members:   members,
+		threshold: threshold,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RegisterCouncilFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := types.NewCouncil(fact.members, fact.threshold).IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, m := range fact.members {
+		if m.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("council member %v is same with contract", m)))
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RegisterCouncilFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RegisterCouncilFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RegisterCouncilFact) Bytes() []byte {
+	as := make([][]byte, len(fact.members))
+	for i, m := range fact.members {
+		as[i] = m.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(as...),
+		util.UintToBytes(fact.threshold),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RegisterCouncilFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RegisterCouncilFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact RegisterCouncilFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact RegisterCouncilFact) Members() []mitumbase.Address {
+	return fact.members
+}
+
+func (fact RegisterCouncilFact) Threshold() uint {
+	return fact.threshold
+}
+
+func (fact RegisterCouncilFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RegisterCouncilFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type RegisterCouncil struct {
+	common.BaseOperation
+}
+
+func NewRegisterCouncil(fact RegisterCouncilFact) (RegisterCouncil, error) {
+	return RegisterCouncil{BaseOperation: common.NewBaseOperation(RegisterCouncilHint, fact)}, nil
+}
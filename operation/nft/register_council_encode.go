@@ -0,0 +1,43 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RegisterCouncilFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	bms []string,
+	th uint,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.threshold = th
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	members := make([]mitumbase.Address, len(bms))
+	for i, bm := range bms {
+		m, err := mitumbase.DecodeAddress(bm, enc)
+		if err != nil {
+			return err
+		}
+		members[i] = m
+	}
+	fact.members = members
+
+	return nil
+}
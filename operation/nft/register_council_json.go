@@ -0,0 +1,74 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RegisterCouncilFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender    mitumbase.Address        `json:"sender"`
+	Contract  mitumbase.Address        `json:"contract"`
+	Members   []mitumbase.Address      `json:"members"`
+	Threshold uint                     `json:"threshold"`
+	Currency  currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RegisterCouncilFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RegisterCouncilFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Members:               fact.members,
+		Threshold:             fact.threshold,
+		Currency:              fact.currency,
+	})
+}
+
+type RegisterCouncilFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender    string   `json:"sender"`
+	Contract  string   `json:"contract"`
+	Members   []string `json:"members"`
+	Threshold uint     `json:"threshold"`
+	Currency  string   `json:"currency"`
+}
+
+func (fact *RegisterCouncilFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u RegisterCouncilFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Members, u.Threshold, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RegisterCouncilMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RegisterCouncil) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RegisterCouncilMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RegisterCouncil) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
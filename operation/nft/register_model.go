@@ -24,6 +24,10 @@ type RegisterModelFact struct {
 	royalty         types.PaymentParameter
 	uri             types.URI
 	minterWhitelist []base.Address
+	clawback        bool
+	uniqueHash      bool
+	metadata        types.CollectionMetadata
+	uriPolicy       types.URIPolicy
 	currency        currencytypes.CurrencyID
 }
 
@@ -35,6 +39,10 @@ func NewRegisterModelFact(
 	royalty types.PaymentParameter,
 	uri types.URI,
 	whitelist []base.Address,
+	clawback bool,
+	uniqueHash bool,
+	metadata types.CollectionMetadata,
+	uriPolicy types.URIPolicy,
 	currency currencytypes.CurrencyID,
 ) RegisterModelFact {
 	bf := base.NewBaseFact(RegisterModelFactHint, token)
@@ -46,6 +54,10 @@ func NewRegisterModelFact(
 		royalty:         royalty,
 		uri:             uri,
 		minterWhitelist: whitelist,
+		clawback:        clawback,
+		uniqueHash:      uniqueHash,
+		metadata:        metadata,
+		uriPolicy:       uriPolicy,
 		currency:        currency,
 	}
 	fact.SetHash(fact.GenerateHash())
@@ -64,6 +76,8 @@ func (fact RegisterModelFact) IsValid(b []byte) error {
 		fact.name,
 		fact.royalty,
 		fact.uri,
+		fact.metadata,
+		fact.uriPolicy,
 		fact.currency,
 	); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
@@ -96,6 +110,10 @@ func (fact RegisterModelFact) IsValid(b []byte) error {
 		founds[white.String()] = struct{}{}
 	}
 
+	if err := fact.uriPolicy.PermitsAll(fact.uri, fact.metadata.Image(), fact.metadata.Banner()); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -117,6 +135,16 @@ func (fact RegisterModelFact) Bytes() []byte {
 		as[i] = white.Bytes()
 	}
 
+	var cb []byte
+	if fact.clawback {
+		cb = []byte{1}
+	}
+
+	var uh []byte
+	if fact.uniqueHash {
+		uh = []byte{1}
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
@@ -126,6 +154,10 @@ func (fact RegisterModelFact) Bytes() []byte {
 		fact.uri.Bytes(),
 		fact.currency.Bytes(),
 		util.ConcatBytesSlice(as...),
+		cb,
+		uh,
+		fact.metadata.Bytes(),
+		fact.uriPolicy.Bytes(),
 	)
 }
 
@@ -157,6 +189,14 @@ func (fact RegisterModelFact) WhiteList() []base.Address {
 	return fact.minterWhitelist
 }
 
+func (fact RegisterModelFact) Clawback() bool {
+	return fact.clawback
+}
+
+func (fact RegisterModelFact) UniqueHash() bool {
+	return fact.uniqueHash
+}
+
 func (fact RegisterModelFact) Addresses() ([]base.Address, error) {
 	l := 2 + len(fact.minterWhitelist)
 
@@ -169,6 +209,14 @@ func (fact RegisterModelFact) Addresses() ([]base.Address, error) {
 	return as, nil
 }
 
+func (fact RegisterModelFact) Metadata() types.CollectionMetadata {
+	return fact.metadata
+}
+
+func (fact RegisterModelFact) URIPolicy() types.URIPolicy {
+	return fact.uriPolicy
+}
+
 func (fact RegisterModelFact) Currency() currencytypes.CurrencyID {
 	return fact.currency
 }
@@ -20,19 +20,27 @@ func (fact RegisterModelFact) MarshalBSON() ([]byte, error) {
 		"royalty":          fact.royalty,
 		"uri":              fact.uri,
 		"minter_whitelist": fact.minterWhitelist,
+		"clawback":         fact.clawback,
+		"unique_hash":      fact.uniqueHash,
+		"metadata":         fact.metadata,
+		"uri_policy":       fact.uriPolicy,
 		"currency":         fact.currency,
 	})
 }
 
 type RegisterModelFactBSONUnmarshaler struct {
-	Hint      string   `bson:"_hint"`
-	Sender    string   `bson:"sender"`
-	Contract  string   `bson:"contract"`
-	Name      string   `bson:"name"`
-	Royalty   uint     `bson:"royalty"`
-	URI       string   `bson:"uri"`
-	Whitelist []string `bson:"minter_whitelist"`
-	Currency  string   `bson:"currency"`
+	Hint       string   `bson:"_hint"`
+	Sender     string   `bson:"sender"`
+	Contract   string   `bson:"contract"`
+	Name       string   `bson:"name"`
+	Royalty    uint     `bson:"royalty"`
+	URI        string   `bson:"uri"`
+	Whitelist  []string `bson:"minter_whitelist"`
+	Clawback   bool     `bson:"clawback"`
+	UniqueHash bool     `bson:"unique_hash"`
+	Metadata   bson.Raw `bson:"metadata"`
+	URIPolicy  bson.Raw `bson:"uri_policy"`
+	Currency   string   `bson:"currency"`
 }
 
 func (fact *RegisterModelFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -57,7 +65,7 @@ func (fact *RegisterModelFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	if err := fact.unmarshal(enc, uf.Sender, uf.Contract, uf.Name, uf.Royalty, uf.URI, uf.Whitelist, uf.Currency); err != nil {
+	if err := fact.unmarshal(enc, uf.Sender, uf.Contract, uf.Name, uf.Royalty, uf.URI, uf.Whitelist, uf.Clawback, uf.UniqueHash, uf.Metadata, uf.URIPolicy, uf.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
 	}
 
@@ -15,6 +15,10 @@ func (fact *RegisterModelFact) unmarshal(
 	ry uint,
 	uri string,
 	bws []string,
+	cb bool,
+	uh bool,
+	bmd []byte,
+	bup []byte,
 	cid string,
 ) error {
 	fact.currency = currencytypes.CurrencyID(cid)
@@ -28,6 +32,8 @@ func (fact *RegisterModelFact) unmarshal(
 	fact.name = types.CollectionName(nm)
 	fact.royalty = types.PaymentParameter(ry)
 	fact.uri = types.URI(uri)
+	fact.clawback = cb
+	fact.uniqueHash = uh
 
 	contract, err := mitumbase.DecodeAddress(ca, enc)
 	if err != nil {
@@ -46,5 +52,17 @@ func (fact *RegisterModelFact) unmarshal(
 	}
 	fact.minterWhitelist = whitelist
 
+	metadata, err := types.DecodeCollectionMetadata(enc, bmd)
+	if err != nil {
+		return err
+	}
+	fact.metadata = metadata
+
+	uriPolicy, err := types.DecodeURIPolicy(enc, bup)
+	if err != nil {
+		return err
+	}
+	fact.uriPolicy = uriPolicy
+
 	return nil
 }
@@ -1,6 +1,8 @@
 package nft
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum-nft/types"
@@ -11,13 +13,17 @@ import (
 
 type RegisterModelFactJSONMarshaler struct {
 	mitumbase.BaseFactJSONMarshaler
-	Sender    mitumbase.Address        `json:"sender"`
-	Contract  mitumbase.Address        `json:"contract"`
-	Name      types.CollectionName     `json:"name"`
-	Royalty   types.PaymentParameter   `json:"royalty"`
-	URI       types.URI                `json:"uri"`
-	Whitelist []mitumbase.Address      `json:"minter_whitelist"`
-	Currency  currencytypes.CurrencyID `json:"currency"`
+	Sender     mitumbase.Address        `json:"sender"`
+	Contract   mitumbase.Address        `json:"contract"`
+	Name       types.CollectionName     `json:"name"`
+	Royalty    types.PaymentParameter   `json:"royalty"`
+	URI        types.URI                `json:"uri"`
+	Whitelist  []mitumbase.Address      `json:"minter_whitelist"`
+	Clawback   bool                     `json:"clawback"`
+	UniqueHash bool                     `json:"unique_hash"`
+	Metadata   types.CollectionMetadata `json:"metadata"`
+	URIPolicy  types.URIPolicy          `json:"uri_policy"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
 }
 
 func (fact RegisterModelFact) MarshalJSON() ([]byte, error) {
@@ -29,19 +35,27 @@ func (fact RegisterModelFact) MarshalJSON() ([]byte, error) {
 		Royalty:               fact.royalty,
 		URI:                   fact.uri,
 		Whitelist:             fact.minterWhitelist,
+		Clawback:              fact.clawback,
+		UniqueHash:            fact.uniqueHash,
+		Metadata:              fact.metadata,
+		URIPolicy:             fact.uriPolicy,
 		Currency:              fact.currency,
 	})
 }
 
 type RegisterModelFactJSONUnmarshaler struct {
 	mitumbase.BaseFactJSONUnmarshaler
-	Sender    string   `json:"sender"`
-	Contract  string   `json:"contract"`
-	Name      string   `json:"name"`
-	Royalty   uint     `json:"royalty"`
-	URI       string   `json:"uri"`
-	Whitelist []string `json:"minter_whitelist"`
-	Currency  string   `json:"currency"`
+	Sender     string          `json:"sender"`
+	Contract   string          `json:"contract"`
+	Name       string          `json:"name"`
+	Royalty    uint            `json:"royalty"`
+	URI        string          `json:"uri"`
+	Whitelist  []string        `json:"minter_whitelist"`
+	Clawback   bool            `json:"clawback"`
+	UniqueHash bool            `json:"unique_hash"`
+	Metadata   json.RawMessage `json:"metadata"`
+	URIPolicy  json.RawMessage `json:"uri_policy"`
+	Currency   string          `json:"currency"`
 }
 
 func (fact *RegisterModelFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -51,7 +65,7 @@ func (fact *RegisterModelFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	}
 
 	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
-	if err := fact.unmarshal(enc, u.Sender, u.Contract, u.Name, u.Royalty, u.URI, u.Whitelist, u.Currency); err != nil {
+	if err := fact.unmarshal(enc, u.Sender, u.Contract, u.Name, u.Royalty, u.URI, u.Whitelist, u.Clawback, u.UniqueHash, u.Metadata, u.URIPolicy, u.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
 	}
 
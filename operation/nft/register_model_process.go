@@ -62,6 +62,8 @@ func NewRegisterModelProcessor() ctypes.GetNewProcessor {
 func (opp *RegisterModelProcessor) PreProcess(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
 ) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(RegisterModelFact)
 	if !ok {
 		return ctx, mitumbase.NewBaseOperationProcessReasonError(
@@ -122,16 +124,38 @@ func (opp *RegisterModelProcessor) PreProcess(
 				"contract account %v has already been activated", fact.Contract())), nil
 	}
 
-	if found, _ := cstate.CheckNotExistsState(state.NFTStateKey(fact.contract, state.CollectionKey), getStateFunc); found {
+	unregistered, err := isUnregisteredCollection(fact.Contract(), getStateFunc)
+	if err != nil {
 		return ctx, mitumbase.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
-				Wrap(common.ErrMServiceE).Errorf("nft collection for contract account %v", fact.Contract())), nil
+				Errorf("%v", err)), nil
+	}
+
+	if !unregistered {
+		if found, _ := cstate.CheckNotExistsState(state.NFTStateKey(fact.contract, state.CollectionKey), getStateFunc); found {
+			return ctx, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMServiceE).Errorf("nft collection for contract account %v", fact.Contract())), nil
+		}
+
+		if found, _ := cstate.CheckNotExistsState(state.NFTStateKey(fact.contract, state.LastIDXKey), getStateFunc); found {
+			return ctx, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMServiceE).Errorf("nft collection for contract account %v: last index already exists", fact.Contract())), nil
+		}
 	}
 
-	if found, _ := cstate.CheckNotExistsState(state.NFTStateKey(fact.contract, state.LastIDXKey), getStateFunc); found {
+	if err := checkCollectionName(fact.Name(), fact.Contract(), getStateFunc); err != nil {
 		return ctx, mitumbase.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
-				Wrap(common.ErrMServiceE).Errorf("nft collection for contract account %v: last index already exists", fact.Contract())), nil
+				Errorf("%v", err)), nil
+	}
+
+	nctx, err := checkCollectionNameInBlock(ctx, fact.Name(), fact.Contract())
+	if err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
 	}
 
 	whitelist := fact.WhiteList()
@@ -153,13 +177,15 @@ func (opp *RegisterModelProcessor) PreProcess(
 		//}
 	}
 
-	return ctx, nil, nil
+	return nctx, nil, nil
 }
 
 func (opp *RegisterModelProcessor) Process(
 	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
 	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
 ) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	e := util.StringError("process RegisterModel")
 
 	fact, ok := op.Fact().(RegisterModelFact)
@@ -178,7 +204,7 @@ func (opp *RegisterModelProcessor) Process(
 		}
 	}
 
-	policy := types.NewCollectionPolicy(fact.Name(), fact.Royalty(), fact.URI(), fact.WhiteList())
+	policy := types.NewCollectionPolicy(fact.Name(), fact.Royalty(), fact.URI(), fact.WhiteList(), fact.Clawback(), fact.UniqueHash(), fact.Metadata(), fact.URIPolicy())
 	design := types.NewDesign(fact.Contract(), fact.Sender(), true, policy)
 	if err := design.IsValid(nil); err != nil {
 		return nil, mitumbase.NewBaseOperationProcessReasonError("invalid collection design, %v: %w", fact.Contract(), err), nil
@@ -188,10 +214,30 @@ func (opp *RegisterModelProcessor) Process(
 		state.NFTStateKey(design.Contract(), state.CollectionKey),
 		state.NewCollectionStateValue(design),
 	))
+	if found, _ := cstate.CheckNotExistsState(state.NFTStateKey(design.Contract(), state.LastIDXKey), getStateFunc); !found {
+		sts = append(sts, cstate.NewStateMergeValue(
+			state.NFTStateKey(design.Contract(), state.LastIDXKey),
+			state.NewLastNFTIndexStateValue(0),
+		))
+	}
 	sts = append(sts, cstate.NewStateMergeValue(
-		state.NFTStateKey(design.Contract(), state.LastIDXKey),
-		state.NewLastNFTIndexStateValue(0),
+		state.StateKeyRoles(design.Contract(), fact.Sender()),
+		state.NewRolesStateValue([]types.Role{types.RoleAdmin}),
 	))
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.NFTStateKey(design.Contract(), state.RegistrationKey),
+		state.NewRegistrationStateValue(opp.Height()),
+	))
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.NFTStateKey(design.Contract(), state.SupplyKey),
+		state.NewSupplyStateValue(0),
+	))
+
+	nsts, err := claimCollectionName("", fact.Name(), fact.Contract(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to claim collection name, %v: %w", fact.Name(), err), nil
+	}
+	sts = append(sts, nsts...)
 
 	st, err := cstate.ExistsState(statee.StateKeyContractAccount(fact.Contract()), "contract account", getStateFunc)
 	if err != nil {
@@ -0,0 +1,81 @@
+package nft
+
+import (
+	"context"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+)
+
+// registeredStateFunc hides the states kept per registration of collection,
+// like roles, council and sponsors, which were written before the latest
+// registration. A collection registered again after unregistered starts with
+// none of the states of the previous registration.
+func registeredStateFunc(getStateFunc mitumbase.GetStateFunc) mitumbase.GetStateFunc {
+	return func(key string) (mitumbase.State, bool, error) {
+		st, found, err := getStateFunc(key)
+		if err != nil || !found {
+			return st, found, err
+		}
+
+		rkey, ok := statenft.RegistrationStateKeyOf(key)
+		if !ok {
+			return st, found, nil
+		}
+
+		rst, rfound, err := getStateFunc(rkey)
+		switch {
+		case err != nil:
+			return nil, false, err
+		case !rfound:
+			return st, found, nil
+		}
+
+		height, err := statenft.StateRegistrationValue(rst)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if st.Height() < height {
+			return nil, false, nil
+		}
+
+		return st, found, nil
+	}
+}
+
+// NewRegisteredProcessor makes the processor of f see only the states of the
+// latest registration of collection, so no nft processor can read the states
+// left by the previous registration; see registeredStateFunc.
+func NewRegisteredProcessor(f currencytypes.GetNewProcessor) currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		opp, err := f(height, registeredStateFunc(getStateFunc), newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		return registeredProcessor{OperationProcessor: opp}, nil
+	}
+}
+
+type registeredProcessor struct {
+	mitumbase.OperationProcessor
+}
+
+func (opp registeredProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	return opp.OperationProcessor.PreProcess(ctx, op, registeredStateFunc(getStateFunc))
+}
+
+func (opp registeredProcessor) Process(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return opp.OperationProcessor.Process(ctx, op, registeredStateFunc(getStateFunc))
+}
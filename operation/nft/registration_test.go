@@ -0,0 +1,196 @@
+package nft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+func newTestState(height mitumbase.Height, key string, value mitumbase.StateValue) mitumbase.State {
+	return common.NewBaseState(height, key, value, nil, []util.Hash{})
+}
+
+// newTestGetStateFunc returns the GetStateFunc over sts, like the states
+// before a block.
+func newTestGetStateFunc(sts ...mitumbase.State) mitumbase.GetStateFunc {
+	m := map[string]mitumbase.State{}
+	for i := range sts {
+		m[sts[i].Key()] = sts[i]
+	}
+
+	return func(key string) (mitumbase.State, bool, error) {
+		st, found := m[key]
+
+		return st, found, nil
+	}
+}
+
+func TestRegisteredStateFunc(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	account := mitumbase.NewStringAddress("account")
+
+	rolesKey := statenft.StateKeyRoles(contract, account)
+	councilKey := statenft.NFTStateKey(contract, statenft.CouncilKey)
+	lastKey := statenft.NFTStateKey(contract, statenft.LastIDXKey)
+
+	getStateFunc := registeredStateFunc(newTestGetStateFunc(
+		newTestState(10, statenft.NFTStateKey(contract, statenft.RegistrationKey), statenft.NewRegistrationStateValue(10)),
+		newTestState(5, rolesKey, statenft.NewRolesStateValue([]types.Role{types.RoleAdmin})),
+		newTestState(10, councilKey, statenft.NewCouncilStateValue(types.NewCouncil([]mitumbase.Address{account}, 1))),
+		newTestState(5, lastKey, statenft.NewLastNFTIndexStateValue(3)),
+	))
+
+	// NOTE the roles are granted in the previous registration.
+	if _, found, err := getStateFunc(rolesKey); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	} else if found {
+		t.Error("roles of previous registration should be hidden")
+	}
+
+	if _, found, err := getStateFunc(councilKey); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	} else if !found {
+		t.Error("council of current registration should be found")
+	}
+
+	// NOTE the nft index is kept across registrations.
+	if _, found, err := getStateFunc(lastKey); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	} else if !found {
+		t.Error("last nft index should be found")
+	}
+
+	if _, found, err := getStateFunc(statenft.StateKeyRoles(contract, mitumbase.NewStringAddress("other"))); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	} else if found {
+		t.Error("unknown roles should not be found")
+	}
+}
+
+func TestRegisteredStateFuncLegacy(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	account := mitumbase.NewStringAddress("account")
+	rolesKey := statenft.StateKeyRoles(contract, account)
+
+	// NOTE the collection registered before the registration height was kept.
+	getStateFunc := registeredStateFunc(newTestGetStateFunc(
+		newTestState(5, rolesKey, statenft.NewRolesStateValue([]types.Role{types.RoleAdmin})),
+	))
+
+	if _, found, err := getStateFunc(rolesKey); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	} else if !found {
+		t.Error("roles of collection without registration height should be found")
+	}
+}
+
+func TestSupplyStateMergeValue(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	key := statenft.NFTStateKey(contract, statenft.SupplyKey)
+
+	switch smv, err := supplyStateMergeValue(contract, 1, newTestGetStateFunc()); {
+	case err != nil:
+		t.Fatalf("unexpected error, %v", err)
+	case smv != nil:
+		t.Error("supply of legacy collection should not be changed")
+	}
+
+	smv, err := supplyStateMergeValue(contract, -1, newTestGetStateFunc(
+		newTestState(3, key, statenft.NewSupplyStateValue(2)),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	if smv == nil || smv.Key() != key {
+		t.Fatalf("expected supply merge value of %q, not %v", key, smv)
+	}
+
+	if v, ok := smv.Value().(statenft.SupplyDeltaStateValue); !ok || v.Delta != -1 {
+		t.Errorf("expected supply delta -1, not %v", smv.Value())
+	}
+}
+
+// testSenderProcessor checks the sender of nft like the item processors of
+// transfer and transfer with lock.
+type testSenderProcessor struct {
+	contract mitumbase.Address
+	sender   mitumbase.Address
+	nft      *types.NFT
+}
+
+func (opp testSenderProcessor) PreProcess(
+	ctx context.Context, _ mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	if err := checkNFTSender(opp.contract, opp.sender, opp.nft, getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError("%v", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (testSenderProcessor) Process(
+	context.Context, mitumbase.Operation, mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+func (testSenderProcessor) Close() error {
+	return nil
+}
+
+func TestRegisteredProcessorStaleOperators(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	owner := mitumbase.NewStringAddress("owner")
+	operator := mitumbase.NewStringAddress("operator")
+
+	n := types.NewNFT(0, true, owner, types.NFTHash("nfthash"), types.URI(""), owner, types.NewSigners(nil), nil, types.Provenance{})
+	registration := newTestState(10, statenft.NFTStateKey(contract, statenft.RegistrationKey), statenft.NewRegistrationStateValue(10))
+	operatorsKey := statenft.StateKeyOperators(contract, owner)
+	operators := statenft.NewOperatorsBookStateValue(types.NewAllApprovedBook([]mitumbase.Address{operator}))
+
+	newProcessor := NewRegisteredProcessor(func(
+		mitumbase.Height,
+		mitumbase.GetStateFunc,
+		mitumbase.NewOperationProcessorProcessFunc,
+		mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		return testSenderProcessor{contract: contract, sender: operator, nft: &n}, nil
+	})
+
+	preProcess := func(getStateFunc mitumbase.GetStateFunc) mitumbase.OperationProcessReasonError {
+		opp, err := newProcessor(11, getStateFunc, nil, nil)
+		if err != nil {
+			t.Fatalf("failed to create processor: %v", err)
+		}
+
+		_, reason, err := opp.PreProcess(context.Background(), nil, getStateFunc)
+		if err != nil {
+			t.Fatalf("unexpected error, %v", err)
+		}
+
+		return reason
+	}
+
+	// NOTE the operator approved in the previous registration can not escrow
+	// nft of the collection registered again.
+	stale := newTestGetStateFunc(registration, newTestState(5, operatorsKey, operators))
+
+	if reason := preProcess(stale); reason == nil {
+		t.Error("stale operator: expected reason error")
+	}
+
+	if _, reason, _ := (testSenderProcessor{contract: contract, sender: operator, nft: &n}).PreProcess(
+		context.Background(), nil, stale); reason != nil {
+		t.Errorf("stale operator without registered processor: unexpected reason error, %v", reason)
+	}
+
+	if reason := preProcess(newTestGetStateFunc(registration, newTestState(12, operatorsKey, operators))); reason != nil {
+		t.Errorf("operator of current registration: unexpected reason error, %v", reason)
+	}
+}
@@ -0,0 +1,48 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// checkRestriction checks whether receiver is permitted to receive nft of the collection
+// under the restriction mode of the contract. A collection without restriction mode permits every receiver.
+func checkRestriction(contract, receiver mitumbase.Address, getStateFunc mitumbase.GetStateFunc) error {
+	st, found, err := getStateFunc(statenft.NFTStateKey(contract, statenft.RestrictionModeKey))
+	if err != nil {
+		return err
+	} else if !found {
+		return nil
+	}
+
+	mode, err := statenft.StateRestrictionModeValue(st)
+	if err != nil {
+		return common.ErrStateValInvalid.Wrap(
+			errors.Errorf("restriction mode for contract account %v", contract))
+	}
+
+	if mode == types.RestrictionNone {
+		return nil
+	}
+
+	var listed bool
+	switch st, found, err := getStateFunc(statenft.StateKeyRestriction(contract, receiver)); {
+	case err != nil:
+		return err
+	case found:
+		if listed, err = statenft.StateRestrictionValue(st); err != nil {
+			return common.ErrStateValInvalid.Wrap(
+				errors.Errorf("restriction of receiver %v for contract account %v", receiver, contract))
+		}
+	}
+
+	if !mode.Permits(listed) {
+		return common.ErrAccountNAth.Wrap(
+			errors.Errorf("receiver %v is restricted by %v list of contract account %v", receiver, mode, contract))
+	}
+
+	return nil
+}
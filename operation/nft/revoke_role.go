@@ -0,0 +1,139 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RevokeRoleFactHint = hint.MustNewHint("mitum-nft-revoke-role-operation-fact-v0.0.1")
+	RevokeRoleHint     = hint.MustNewHint("mitum-nft-revoke-role-operation-v0.0.1")
+)
+
+type RevokeRoleFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	account  mitumbase.Address
+	role     types.Role
+	currency currencytypes.CurrencyID
+}
+
+func NewRevokeRoleFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	account mitumbase.Address,
+	role types.Role,
+	currency currencytypes.CurrencyID,
+) RevokeRoleFact {
+	bf := mitumbase.NewBaseFact(RevokeRoleFactHint, token)
+
+	fact := RevokeRoleFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		account:  account,
+		role:     role,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeRoleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.account,
+		fact.role,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.account.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract", fact.account)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RevokeRoleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeRoleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeRoleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.account.Bytes(),
+		fact.role.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeRoleFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeRoleFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact RevokeRoleFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact RevokeRoleFact) Account() mitumbase.Address {
+	return fact.account
+}
+
+func (fact RevokeRoleFact) Role() types.Role {
+	return fact.role
+}
+
+func (fact RevokeRoleFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeRoleFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.account
+	return as, nil
+}
+
+type RevokeRole struct {
+	common.BaseOperation
+}
+
+func NewRevokeRole(fact RevokeRoleFact) (RevokeRole, error) {
+	return RevokeRole{BaseOperation: common.NewBaseOperation(RevokeRoleHint, fact)}, nil
+}
@@ -0,0 +1,40 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RevokeRoleFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	ac string,
+	rl string,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.role = types.Role(rl)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	account, err := mitumbase.DecodeAddress(ac, enc)
+	if err != nil {
+		return err
+	}
+	fact.account = account
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RevokeRoleFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender   mitumbase.Address        `json:"sender"`
+	Contract mitumbase.Address        `json:"contract"`
+	Account  mitumbase.Address        `json:"account"`
+	Role     types.Role               `json:"role"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeRoleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeRoleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Account:               fact.account,
+		Role:                  fact.role,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeRoleFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	Account  string `json:"account"`
+	Role     string `json:"role"`
+	Currency string `json:"currency"`
+}
+
+func (fact *RevokeRoleFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u RevokeRoleFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Account, u.Role, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RevokeRoleMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeRole) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeRoleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeRole) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
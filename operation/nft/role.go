@@ -0,0 +1,72 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	stateextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// rolesOf returns the roles granted to account in the collection of contract.
+func rolesOf(contract, account mitumbase.Address, getStateFunc mitumbase.GetStateFunc) ([]types.Role, error) {
+	switch st, found, err := getStateFunc(statenft.StateKeyRoles(contract, account)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		roles, err := statenft.StateRolesValue(st)
+		if err != nil {
+			return nil, common.ErrStateValInvalid.Wrap(
+				errors.Errorf("roles of account %v for contract account %v", account, contract))
+		}
+
+		return roles, nil
+	}
+}
+
+// hasRole reports whether account has been granted any of roles in the collection of contract.
+func hasRole(
+	contract, account mitumbase.Address, getStateFunc mitumbase.GetStateFunc, roles ...types.Role,
+) (bool, error) {
+	granted, err := rolesOf(contract, account, getStateFunc)
+	if err != nil {
+		return false, err
+	}
+
+	for _, g := range granted {
+		for _, r := range roles {
+			if g == r {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// checkCollectionAuth checks whether sender can administrate the collection;
+// the owner and operators of the contract account always can, other accounts need one of roles.
+func checkCollectionAuth(
+	cSt mitumbase.State,
+	contract, sender mitumbase.Address,
+	getStateFunc mitumbase.GetStateFunc,
+	roles ...types.Role,
+) error {
+	if _, err := stateextension.CheckCAAuthFromState(cSt, sender); err == nil {
+		return nil
+	}
+
+	switch ok, err := hasRole(contract, sender, getStateFunc, roles...); {
+	case err != nil:
+		return err
+	case !ok:
+		return common.ErrAccountNAth.Wrap(
+			errors.Errorf("sender %v is neither contract owner, operator nor granted %v in contract account %v",
+				sender, roles, contract))
+	default:
+		return nil
+	}
+}
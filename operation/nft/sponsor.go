@@ -0,0 +1,137 @@
+package nft
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// checkSponsor checks whether sponsor is allowed to pay fees of operations on
+// the collection in contract on behalf of their senders.
+func checkSponsor(contract, sponsor mitumbase.Address, getStateFunc mitumbase.GetStateFunc) error {
+	var allowed bool
+	switch st, found, err := getStateFunc(statenft.StateKeySponsor(contract, sponsor)); {
+	case err != nil:
+		return err
+	case found:
+		if allowed, err = statenft.StateSponsorValue(st); err != nil {
+			return common.ErrStateValInvalid.Wrap(
+				errors.Errorf("sponsor %v for contract account %v", sponsor, contract))
+		}
+	}
+
+	if !allowed {
+		return common.ErrAccountNAth.Wrap(
+			errors.Errorf("fee payer %v is not sponsor of contract account %v", sponsor, contract))
+	}
+
+	return nil
+}
+
+// checkFeePayer checks that feePayer is an account which has signed the
+// operation and is a sponsor of every collection in contracts.
+func checkFeePayer(
+	feePayer mitumbase.Address,
+	contracts []mitumbase.Address,
+	signs []mitumbase.Sign,
+	getStateFunc mitumbase.GetStateFunc,
+) mitumbase.OperationProcessReasonError {
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(feePayer, "fee payer", true, false, getStateFunc); aErr != nil {
+		return mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr))
+	} else if cErr != nil {
+		return mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: fee payer %v is contract account", cErr, feePayer))
+	}
+
+	if err := currencystate.CheckFactSignsByState(feePayer, signs, getStateFunc); err != nil {
+		return mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("fee payer %v: %v", feePayer, err))
+	}
+
+	checked := map[string]struct{}{}
+	for _, contract := range contracts {
+		if _, found := checked[contract.String()]; found {
+			continue
+		}
+
+		if err := checkSponsor(contract, feePayer, getStateFunc); err != nil {
+			return mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMAccountNAth).
+					Errorf("%v", err))
+		}
+
+		checked[contract.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// sponsoredFeesContextKey keeps the fees charged to the fee payers by the
+// sponsored operations preprocessed before in the same block.
+type sponsoredFeesContextKey struct{}
+
+// checkSponsoredFee checks that the balance of feePayer covers the fee of items
+// together with the fees of the other operations it sponsors in the same block,
+// so a sponsor can pay for several operations of a block without overdrawing.
+// It returns the context which carries the accumulated fees.
+func checkSponsoredFee(
+	ctx context.Context,
+	feePayer mitumbase.Address,
+	items []CollectionItem,
+	getStateFunc mitumbase.GetStateFunc,
+) (context.Context, error) {
+	_, required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return ctx, errors.Errorf("failed to calculate fee; %v", err)
+	}
+
+	prev, _ := ctx.Value(sponsoredFeesContextKey{}).(map[string]common.Big)
+	fees := make(map[string]common.Big, len(prev)+len(required))
+	for k := range prev {
+		fees[k] = prev[k]
+	}
+
+	for cid := range required {
+		fee := required[cid][1]
+		if !fee.OverZero() {
+			continue
+		}
+
+		key := statecurrency.BalanceStateKey(feePayer, cid)
+		total := fee
+		if k, found := fees[key]; found {
+			total = total.Add(k)
+		}
+
+		st, err := currencystate.ExistsState(key, "balance of fee payer", getStateFunc)
+		if err != nil {
+			return ctx, err
+		}
+
+		balance, err := statecurrency.StateBalanceValue(st)
+		if err != nil {
+			return ctx, err
+		}
+
+		if balance.Big().Compare(total) < 0 {
+			return ctx, common.ErrValueInvalid.Wrap(errors.Errorf(
+				"not enough balance of fee payer %v for sponsored fees in block, %v < %v",
+				feePayer, balance.Big(), total))
+		}
+
+		fees[key] = total
+	}
+
+	return context.WithValue(ctx, sponsoredFeesContextKey{}, fees), nil
+}
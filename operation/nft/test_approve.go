@@ -101,7 +101,7 @@ func (t *TestApproveProcessor) SetNFT(contract, owner base.Address, nfthash, uri
 	}
 
 	nftID, _ := statenft.StateLastNFTIndexValue(cst)
-	n := nfttypes.NewNFT(nftID, true, owner, nfttypes.NFTHash(nfthash), nfttypes.URI(uri), owner, creators)
+	n := nfttypes.NewNFT(nftID, true, owner, nfttypes.NFTHash(nfthash), nfttypes.URI(uri), owner, creators, nil, nfttypes.Provenance{})
 
 	st := common.NewBaseState(base.Height(1), statenft.StateKeyNFT(contract, nftID), statenft.NewNFTStateValue(n), nil, []util.Hash{})
 	t.SetState(st, true)
@@ -119,7 +119,7 @@ func (t *TestApproveProcessor) SetService(
 		whs = append(whs, wh.Address())
 	}
 
-	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs)
+	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs, false, false, nfttypes.NewCollectionMetadata("", "", "", "", ""), nfttypes.NewURIPolicy(nil, ""))
 	design := nfttypes.NewDesign(contract, sender, true, policy)
 
 	st := common.NewBaseState(base.Height(1), statenft.NFTStateKey(design.Contract(), statenft.CollectionKey), statenft.NewCollectionStateValue(design), nil, []util.Hash{})
@@ -98,7 +98,7 @@ func (t *TestCreateCollectionProcessor) SetService(
 		whs = append(whs, wh.Address())
 	}
 
-	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs)
+	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs, false, false, nfttypes.NewCollectionMetadata("", "", "", "", ""), nfttypes.NewURIPolicy(nil, ""))
 	design := nfttypes.NewDesign(contract, sender, true, policy)
 
 	st := common.NewBaseState(base.Height(1), statenft.NFTStateKey(design.Contract(), statenft.CollectionKey), statenft.NewCollectionStateValue(design), nil, []util.Hash{})
@@ -139,6 +139,10 @@ func (t *TestCreateCollectionProcessor) MakeOperation(
 			t.royalty,
 			t.uri,
 			whs,
+			false,
+			false,
+			nfttypes.NewCollectionMetadata("", "", "", "", ""),
+			nfttypes.NewURIPolicy(nil, ""),
 			currency,
 		))
 	_ = op.Sign(privatekey, t.NetworkID)
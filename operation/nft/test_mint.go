@@ -73,7 +73,7 @@ func (t *TestMintProcessor) SetNFT(contract, owner base.Address, nfthash, uri st
 	}
 
 	nftID, _ := statenft.StateLastNFTIndexValue(cst)
-	n := nfttypes.NewNFT(nftID, true, owner, nfttypes.NFTHash(nfthash), nfttypes.URI(uri), owner, creators)
+	n := nfttypes.NewNFT(nftID, true, owner, nfttypes.NFTHash(nfthash), nfttypes.URI(uri), owner, creators, nil, nfttypes.Provenance{})
 
 	st := common.NewBaseState(base.Height(1), statenft.StateKeyNFT(contract, nftID), statenft.NewNFTStateValue(n), nil, []util.Hash{})
 	t.SetState(st, true)
@@ -121,7 +121,7 @@ func (t *TestMintProcessor) SetService(
 		whs = append(whs, wh.Address())
 	}
 
-	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs)
+	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs, false, false, nfttypes.NewCollectionMetadata("", "", "", "", ""), nfttypes.NewURIPolicy(nil, ""))
 	design := nfttypes.NewDesign(contract, sender, true, policy)
 
 	st := common.NewBaseState(base.Height(1), statenft.NFTStateKey(design.Contract(), statenft.CollectionKey), statenft.NewCollectionStateValue(design), nil, []util.Hash{})
@@ -163,7 +163,7 @@ func (t *TestMintProcessor) MakeItem(
 	target test.Account, receiver test.Account, hash, uri string, creators nfttypes.Signers, currency types.CurrencyID,
 	targetItems []MintItem,
 ) *TestMintProcessor {
-	item := NewMintItem(target.Address(), receiver.Address(), nfttypes.NFTHash(hash), nfttypes.URI(uri), creators, currency)
+	item := NewMintItem(target.Address(), receiver.Address(), nfttypes.NFTHash(hash), nfttypes.URI(uri), creators, nil, currency)
 	test.UpdateSlice[MintItem](item, targetItems)
 
 	return t
@@ -177,6 +177,7 @@ func (t *TestMintProcessor) MakeOperation(
 			[]byte("token"),
 			sender,
 			items,
+			nil,
 		))
 	_ = op.Sign(privatekey, t.NetworkID)
 	t.Op = op
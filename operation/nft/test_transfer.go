@@ -75,7 +75,7 @@ func (t *TestTransferProcessor) SetNFT(
 	}
 
 	nftID, _ := statenft.StateLastNFTIndexValue(cst)
-	n := nfttypes.NewNFT(nftID, true, owner, nfttypes.NFTHash(nfthash), nfttypes.URI(uri), owner, creators)
+	n := nfttypes.NewNFT(nftID, true, owner, nfttypes.NFTHash(nfthash), nfttypes.URI(uri), owner, creators, nil, nfttypes.Provenance{})
 
 	st := common.NewBaseState(base.Height(1), statenft.StateKeyNFT(contract, nftID), statenft.NewNFTStateValue(n), nil, []util.Hash{})
 	t.SetState(st, true)
@@ -123,7 +123,7 @@ func (t *TestTransferProcessor) SetService(
 		whs = append(whs, wh.Address())
 	}
 
-	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs)
+	policy := nfttypes.NewCollectionPolicy(t.name, t.royalty, t.uri, whs, false, false, nfttypes.NewCollectionMetadata("", "", "", "", ""), nfttypes.NewURIPolicy(nil, ""))
 	design := nfttypes.NewDesign(contract, sender, true, policy)
 
 	st := common.NewBaseState(base.Height(1), statenft.NFTStateKey(design.Contract(), statenft.CollectionKey), statenft.NewCollectionStateValue(design), nil, []util.Hash{})
@@ -179,6 +179,7 @@ func (t *TestTransferProcessor) MakeOperation(
 			[]byte("token"),
 			sender,
 			items,
+			nil,
 		))
 	_ = op.Sign(privatekey, t.NetworkID)
 	t.Op = op
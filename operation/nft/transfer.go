@@ -12,25 +12,31 @@ import (
 )
 
 var (
-	TransferFactHint = hint.MustNewHint("mitum-nft-transfer-operation-fact-v0.0.1")
+	TransferFactHint = hint.MustNewHint("mitum-nft-transfer-operation-fact-v0.0.2")
 	TransferHint     = hint.MustNewHint("mitum-nft-transfer-operation-v0.0.1")
+	// LegacyTransferFactHint is the transfer fact before fee payer; it is decoded into TransferFact without fee payer.
+	LegacyTransferFactHint = hint.MustNewHint("mitum-nft-transfer-operation-fact-v0.0.1")
 )
 
 var MaxTransferItems = 100
 
 type TransferFact struct {
 	mitumbase.BaseFact
-	sender mitumbase.Address
-	items  []TransferItem
+	sender   mitumbase.Address
+	items    []TransferItem
+	feePayer mitumbase.Address
 }
 
-func NewTransferFact(token []byte, sender mitumbase.Address, items []TransferItem) TransferFact {
+func NewTransferFact(
+	token []byte, sender mitumbase.Address, items []TransferItem, feePayer mitumbase.Address,
+) TransferFact {
 	bf := mitumbase.NewBaseFact(TransferFactHint, token)
 
 	fact := TransferFact{
 		BaseFact: bf,
 		sender:   sender,
 		items:    items,
+		feePayer: feePayer,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -74,6 +80,22 @@ func (fact TransferFact) IsValid(b []byte) error {
 		founds[n] = struct{}{}
 	}
 
+	if fact.feePayer != nil {
+		if fact.Hint().Equal(LegacyTransferFactHint) {
+			return common.ErrFactInvalid.Wrap(
+				errors.Errorf("fee payer not allowed in %v", LegacyTransferFactHint))
+		}
+
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("fee payer %v is same with sender", fact.feePayer)))
+		}
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -95,10 +117,16 @@ func (fact TransferFact) Bytes() []byte {
 		is[i] = fact.items[i].Bytes()
 	}
 
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(is...),
+		fp,
 	)
 }
 
@@ -110,6 +138,21 @@ func (fact TransferFact) Sender() mitumbase.Address {
 	return fact.sender
 }
 
+// FeePayer returns the sponsor which pays the fee instead of sender; it is nil
+// when sender pays the fee.
+func (fact TransferFact) FeePayer() mitumbase.Address {
+	return fact.feePayer
+}
+
+// Payer returns the account whose balance is charged for the fee of fact.
+func (fact TransferFact) Payer() mitumbase.Address {
+	if fact.feePayer != nil {
+		return fact.feePayer
+	}
+
+	return fact.sender
+}
+
 func (fact TransferFact) Items() []TransferItem {
 	return fact.items
 }
@@ -127,6 +170,10 @@ func (fact TransferFact) Addresses() ([]mitumbase.Address, error) {
 
 	as = append(as, fact.Sender())
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
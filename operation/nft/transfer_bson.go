@@ -10,19 +10,26 @@ import (
 )
 
 func (fact TransferFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bson.M{
+	m := bson.M{
 		"_hint":  fact.Hint().String(),
 		"hash":   fact.BaseFact.Hash().String(),
 		"token":  fact.BaseFact.Token(),
 		"sender": fact.sender,
 		"items":  fact.items,
-	})
+	}
+
+	if fact.feePayer != nil {
+		m["fee_payer"] = fact.feePayer
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type TransferFactBSONUnmarshaler struct {
-	Hint   string   `bson:"_hint"`
-	Sender string   `bson:"sender"`
-	Items  bson.Raw `bson:"items"`
+	Hint     string   `bson:"_hint"`
+	Sender   string   `bson:"sender"`
+	Items    bson.Raw `bson:"items"`
+	FeePayer string   `bson:"fee_payer,omitempty"`
 }
 
 func (fact *TransferFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -47,7 +54,7 @@ func (fact *TransferFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	if err := fact.unpack(enc, uf.Sender, uf.Items); err != nil {
+	if err := fact.unpack(enc, uf.Sender, uf.Items, uf.FeePayer); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
 	}
 
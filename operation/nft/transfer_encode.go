@@ -11,6 +11,7 @@ func (fact *TransferFact) unpack(
 	enc encoder.Encoder,
 	sd string,
 	bits []byte,
+	fp string,
 ) error {
 	sender, err := base.DecodeAddress(sd, enc)
 	if err != nil {
@@ -34,5 +35,11 @@ func (fact *TransferFact) unpack(
 	}
 	fact.items = items
 
+	feePayer, err := base.DecodeAddress(fp, enc)
+	if err != nil {
+		return err
+	}
+	fact.feePayer = feePayer
+
 	return nil
 }
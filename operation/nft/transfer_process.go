@@ -5,7 +5,6 @@ import (
 	"sync"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
-	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	"github.com/ProtoconNet/mitum-currency/v3/state"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
@@ -104,26 +103,8 @@ func (ipp *TransferItemProcessor) PreProcess(
 		return e.Wrap(errors.Errorf("burned nft idx %v in contract account %v", nid, ipp.item.Contract()))
 	}
 
-	if !(nv.Owner().Equal(ipp.sender) || nv.Approved().Equal(ipp.sender)) {
-		if st, err := state.ExistsState(
-			statenft.StateKeyOperators(ipp.item.Contract(), nv.Owner()), "operators", getStateFunc); err != nil {
-			return e.Wrap(
-				common.ErrAccountNAth.Wrap(
-					errors.Errorf(
-						"sender %v neither nft owner nor operator for nft idx %v in contract account %v: operators state not found",
-						ipp.sender, nid, ipp.item.Contract())))
-
-		} else if box, err := statenft.StateOperatorsBookValue(st); err != nil {
-			return e.Wrap(
-				common.ErrAccountNAth.Wrap(
-					errors.Errorf("sender %v neither nft owner nor operator for nft idx %v in contract account %v: operators state value not found",
-						ipp.sender, nid, ipp.item.Contract())))
-		} else if !box.Exists(ipp.sender) {
-			return e.Wrap(common.ErrValueInvalid.Wrap(
-				common.ErrAccountNAth.Wrap(
-					errors.Errorf("sender %v neither nft owner nor operator for nft idx %v in contract account %v: sender is not in operators ",
-						ipp.sender, nid, ipp.item.Contract()))))
-		}
+	if err := checkNFTSender(it.Contract(), ipp.sender, nv, getStateFunc); err != nil {
+		return e.Wrap(err)
 	}
 
 	if it.receiver.Equal(nv.Owner()) {
@@ -134,6 +115,39 @@ func (ipp *TransferItemProcessor) PreProcess(
 		return e.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("receiver %v is same with sender", it.receiver)))
 	}
 
+	if err := checkRestriction(it.Contract(), it.Receiver(), getStateFunc); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+// checkNFTSender checks that sender can move nft of contract as the owner, the
+// approved or an operator of the owner.
+func checkNFTSender(contract, sender mitumbase.Address, nv *types.NFT, getStateFunc mitumbase.GetStateFunc) error {
+	if nv.Owner().Equal(sender) || nv.Approved().Equal(sender) {
+		return nil
+	}
+
+	nid := nv.ID()
+
+	if st, err := state.ExistsState(
+		statenft.StateKeyOperators(contract, nv.Owner()), "operators", getStateFunc); err != nil {
+		return common.ErrAccountNAth.Wrap(
+			errors.Errorf(
+				"sender %v neither nft owner nor operator for nft idx %v in contract account %v: operators state not found",
+				sender, nid, contract))
+	} else if box, err := statenft.StateOperatorsBookValue(st); err != nil {
+		return common.ErrAccountNAth.Wrap(
+			errors.Errorf("sender %v neither nft owner nor operator for nft idx %v in contract account %v: operators state value not found",
+				sender, nid, contract))
+	} else if !box.Exists(sender) {
+		return common.ErrValueInvalid.Wrap(
+			common.ErrAccountNAth.Wrap(
+				errors.Errorf("sender %v neither nft owner nor operator for nft idx %v in contract account %v: sender is not in operators ",
+					sender, nid, contract)))
+	}
+
 	return nil
 }
 
@@ -162,7 +176,7 @@ func (ipp *TransferItemProcessor) Process(
 		return nil, errors.Errorf("nft value not found, %v: %v", nid, err)
 	}
 
-	n := types.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators())
+	n := types.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators(), nv.Attributes(), nv.Provenance())
 	if err := n.IsValid(nil); err != nil {
 		return nil, errors.Errorf("invalid nft, %v: %v", nid, err)
 	}
@@ -220,6 +234,8 @@ func NewTransferProcessor() currencytypes.GetNewProcessor {
 func (opp *TransferProcessor) PreProcess(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
 ) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(TransferFact)
 	if !ok {
 		return ctx, mitumbase.NewBaseOperationProcessReasonError(
@@ -251,6 +267,17 @@ func (opp *TransferProcessor) PreProcess(
 				Errorf("%v", err)), nil
 	}
 
+	if fact.FeePayer() != nil {
+		contracts := make([]mitumbase.Address, len(fact.Items()))
+		for i, item := range fact.Items() {
+			contracts[i] = item.Contract()
+		}
+
+		if err := checkFeePayer(fact.FeePayer(), contracts, op.Signs(), getStateFunc); err != nil {
+			return ctx, err, nil
+		}
+	}
+
 	for _, item := range fact.Items() {
 		ip := transferItemProcessorPool.Get()
 		ipc, ok := ip.(*TransferItemProcessor)
@@ -272,6 +299,20 @@ func (opp *TransferProcessor) PreProcess(
 		ipc.Close()
 	}
 
+	if fact.FeePayer() != nil {
+		items := make([]CollectionItem, len(fact.Items()))
+		for i := range fact.Items() {
+			items[i] = fact.Items()[i]
+		}
+
+		nctx, err := checkSponsoredFee(ctx, fact.FeePayer(), items, getStateFunc)
+		if err != nil {
+			return ctx, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err)), nil
+		}
+		ctx = nctx
+	}
+
 	return ctx, nil, nil
 }
 
@@ -279,6 +320,8 @@ func (opp *TransferProcessor) Process( // nolint:dupl
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
 	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
 ) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Transfer")
 
 	fact, ok := op.Fact().(TransferFact)
@@ -312,50 +355,11 @@ func (opp *TransferProcessor) Process( // nolint:dupl
 		items[i] = fact.Items()[i]
 	}
 
-	feeReceiverBalSts, required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	feeSts, err := payCollectionItemsFee(fact.Payer(), items, getStateFunc)
 	if err != nil {
-		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
-	}
-	sb, err := currency.CheckEnoughBalance(fact.Sender(), required, getStateFunc)
-	if err != nil {
-		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
-	}
-
-	for cid := range sb {
-		v, ok := sb[cid].Value().(statecurrency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Errorf("expected BalanceStateValue, not %T", sb[cid].Value())
-		}
-
-		_, feeReceiverFound := feeReceiverBalSts[cid]
-
-		if feeReceiverFound && (sb[cid].Key() != feeReceiverBalSts[cid].Key()) {
-			stmv := common.NewBaseStateMergeValue(
-				sb[cid].Key(),
-				statecurrency.NewDeductBalanceStateValue(v.Amount.WithBig(required[cid][1])),
-				func(height mitumbase.Height, st mitumbase.State) mitumbase.StateValueMerger {
-					return statecurrency.NewBalanceStateValueMerger(height, sb[cid].Key(), cid, st)
-				},
-			)
-
-			r, ok := feeReceiverBalSts[cid].Value().(statecurrency.BalanceStateValue)
-			if !ok {
-				return nil, mitumbase.NewBaseOperationProcessReasonError("expected %T, not %T", statecurrency.BalanceStateValue{}, feeReceiverBalSts[cid].Value()), nil
-			}
-			sts = append(
-				sts,
-				common.NewBaseStateMergeValue(
-					feeReceiverBalSts[cid].Key(),
-					statecurrency.NewAddBalanceStateValue(r.Amount.WithBig(required[cid][1])),
-					func(height mitumbase.Height, st mitumbase.State) mitumbase.StateValueMerger {
-						return statecurrency.NewBalanceStateValueMerger(height, feeReceiverBalSts[cid].Key(), cid, st)
-					},
-				),
-			)
-
-			sts = append(sts, stmv)
-		}
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
 	}
+	sts = append(sts, feeSts...)
 
 	return sts, nil, nil
 }
@@ -0,0 +1,139 @@
+package nft
+
+import (
+	"strconv"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	TransferWithLockFactHint = hint.MustNewHint("mitum-nft-transfer-with-lock-operation-fact-v0.0.1")
+	TransferWithLockHint     = hint.MustNewHint("mitum-nft-transfer-with-lock-operation-v0.0.1")
+)
+
+var MaxTransferWithLockItems = 100
+
+type TransferWithLockFact struct {
+	mitumbase.BaseFact
+	sender mitumbase.Address
+	items  []TransferWithLockItem
+}
+
+func NewTransferWithLockFact(token []byte, sender mitumbase.Address, items []TransferWithLockItem) TransferWithLockFact {
+	bf := mitumbase.NewBaseFact(TransferWithLockFactHint, token)
+
+	fact := TransferWithLockFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact TransferWithLockFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := len(fact.items); l < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty items for TransferWithLockFact")))
+	} else if l > int(MaxTransferWithLockItems) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, MaxTransferWithLockItems)))
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.sender.Equal(item.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+		}
+
+		n := item.contract.String() + "-" + strconv.FormatUint(item.NFT(), 10)
+
+		if _, found := founds[n]; found {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrDupVal.Wrap(errors.Errorf("nft idx %v in contract account %v", item.NFT(), item.contract)))
+		}
+
+		founds[n] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact TransferWithLockFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact TransferWithLockFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferWithLockFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact TransferWithLockFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact TransferWithLockFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact TransferWithLockFact) Items() []TransferWithLockItem {
+	return fact.items
+}
+
+func (fact TransferWithLockFact) Addresses() ([]mitumbase.Address, error) {
+	as := []mitumbase.Address{}
+
+	for i := range fact.items {
+		if ads, err := fact.items[i].Addresses(); err != nil {
+			return nil, err
+		} else {
+			as = append(as, ads...)
+		}
+	}
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+type TransferWithLock struct {
+	common.BaseOperation
+}
+
+func NewTransferWithLock(fact TransferWithLockFact) (TransferWithLock, error) {
+	return TransferWithLock{BaseOperation: common.NewBaseOperation(TransferWithLockHint, fact)}, nil
+}
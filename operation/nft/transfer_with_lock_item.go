@@ -0,0 +1,88 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var TransferWithLockItemHint = hint.MustNewHint("mitum-nft-transfer-with-lock-item-v0.0.1")
+
+type TransferWithLockItem struct {
+	hint.BaseHinter
+	contract      mitumbase.Address
+	receiver      mitumbase.Address
+	nftIdx        uint64
+	releaseHeight mitumbase.Height
+	currency      types.CurrencyID
+}
+
+func NewTransferWithLockItem(
+	contract mitumbase.Address,
+	receiver mitumbase.Address,
+	nft uint64,
+	releaseHeight mitumbase.Height,
+	currency types.CurrencyID,
+) TransferWithLockItem {
+	return TransferWithLockItem{
+		BaseHinter:    hint.NewBaseHinter(TransferWithLockItemHint),
+		contract:      contract,
+		receiver:      receiver,
+		nftIdx:        nft,
+		releaseHeight: releaseHeight,
+		currency:      currency,
+	}
+}
+
+func (it TransferWithLockItem) IsValid([]byte) error {
+	if it.receiver.Equal(it.contract) {
+		return common.ErrSelfTarget.Wrap(errors.Errorf("receiver %v is same with contract account", it.receiver))
+	}
+
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.receiver,
+		it.releaseHeight,
+		it.currency,
+	)
+}
+
+func (it TransferWithLockItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.receiver.Bytes(),
+		util.Uint64ToBytes(it.nftIdx),
+		it.releaseHeight.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it TransferWithLockItem) Contract() mitumbase.Address {
+	return it.contract
+}
+
+func (it TransferWithLockItem) Receiver() mitumbase.Address {
+	return it.receiver
+}
+
+func (it TransferWithLockItem) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = it.receiver
+	return as, nil
+}
+
+func (it TransferWithLockItem) NFT() uint64 {
+	return it.nftIdx
+}
+
+func (it TransferWithLockItem) ReleaseHeight() mitumbase.Height {
+	return it.releaseHeight
+}
+
+func (it TransferWithLockItem) Currency() types.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,36 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *TransferWithLockItem) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ca, rc string,
+	nid uint64,
+	rh int64,
+	cid string,
+) error {
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	switch a, err := mitumbase.DecodeAddress(ca, enc); {
+	case err != nil:
+		return err
+	default:
+		it.contract = a
+	}
+
+	receiver, err := mitumbase.DecodeAddress(rc, enc)
+	if err != nil {
+		return err
+	}
+	it.receiver = receiver
+	it.nftIdx = nid
+	it.releaseHeight = mitumbase.Height(rh)
+	it.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type TransferWithLockItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract      mitumbase.Address `json:"contract"`
+	Receiver      mitumbase.Address `json:"receiver"`
+	NFTIdx        uint64            `json:"nft_idx"`
+	ReleaseHeight mitumbase.Height  `json:"release_height"`
+	Currency      types.CurrencyID  `json:"currency"`
+}
+
+func (it TransferWithLockItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TransferWithLockItemJSONMarshaler{
+		BaseHinter:    it.BaseHinter,
+		Contract:      it.contract,
+		Receiver:      it.receiver,
+		NFTIdx:        it.nftIdx,
+		ReleaseHeight: it.releaseHeight,
+		Currency:      it.currency,
+	})
+}
+
+type TransferWithLockItemJSONUnmarshaler struct {
+	Hint          hint.Hint `json:"_hint"`
+	Contract      string    `json:"contract"`
+	Receiver      string    `json:"receiver"`
+	NFTIdx        uint64    `json:"nft_idx"`
+	ReleaseHeight int64     `json:"release_height"`
+	Currency      string    `json:"currency"`
+}
+
+func (it *TransferWithLockItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u TransferWithLockItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	if err := it.unpack(enc, u.Hint, u.Contract, u.Receiver, u.NFTIdx, u.ReleaseHeight, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	return nil
+}
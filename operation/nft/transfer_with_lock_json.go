@@ -0,0 +1,66 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type TransferWithLockFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender mitumbase.Address      `json:"sender"`
+	Items  []TransferWithLockItem `json:"items"`
+}
+
+func (fact TransferWithLockFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TransferWithLockFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type TransferWithLockFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *TransferWithLockFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u TransferWithLockFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Items); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type transferWithLockMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op TransferWithLock) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(transferWithLockMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *TransferWithLock) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
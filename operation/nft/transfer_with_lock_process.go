@@ -0,0 +1,273 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	statenft "github.com/ProtoconNet/mitum-nft/state"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var transferWithLockItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferWithLockItemProcessor)
+	},
+}
+
+var transferWithLockProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferWithLockProcessor)
+	},
+}
+
+func (TransferWithLock) Process(
+	_ context.Context, _ mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type TransferWithLockItemProcessor struct {
+	h      util.Hash
+	sender mitumbase.Address
+	item   TransferWithLockItem
+	height mitumbase.Height
+}
+
+func (ipp *TransferWithLockItemProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) error {
+	e := util.StringError("preprocess TransferWithLockItemProcessor")
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if it.ReleaseHeight() <= ipp.height {
+		return e.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("release height %v must be greater than current height %v", it.ReleaseHeight(), ipp.height)))
+	}
+
+	tip := &TransferItemProcessor{
+		h:      ipp.h,
+		sender: ipp.sender,
+		item:   NewTransferItem(it.Contract(), it.Receiver(), it.NFT(), it.Currency()),
+	}
+	if err := tip.PreProcess(ctx, op, getStateFunc); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ipp *TransferWithLockItemProcessor) Process(
+	_ context.Context, _ mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) ([]mitumbase.StateMergeValue, error) {
+	receiver := ipp.item.Receiver()
+	var sts []mitumbase.StateMergeValue
+
+	smv, err := currencystate.CreateNotExistAccount(receiver, getStateFunc)
+	if err != nil {
+		return nil, err
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	nid := ipp.item.NFT()
+
+	st, err := state.ExistsState(statenft.StateKeyNFT(ipp.item.Contract(), nid), "nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %v: %v", nid, err)
+	}
+
+	nv, err := statenft.StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %v: %v", nid, err)
+	}
+
+	lock := types.NewNFTLock(nv.Owner(), receiver, ipp.item.ReleaseHeight(), true)
+	if err := lock.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft lock, %v: %v", nid, err)
+	}
+
+	contract := ipp.item.Contract()
+	n := types.NewNFT(nid, nv.Active(), contract, nv.NFTHash(), nv.URI(), contract, nv.Creators(), nv.Attributes(), nv.Provenance())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %v: %v", nid, err)
+	}
+
+	sts = append(
+		sts,
+		state.NewStateMergeValue(
+			statenft.StateKeyNFT(contract, nid), statenft.NewNFTStateValue(n)),
+		state.NewStateMergeValue(
+			statenft.StateKeyNFTLock(contract, nid), statenft.NewNFTLockStateValue(lock)),
+	)
+
+	return sts, nil
+}
+
+func (ipp *TransferWithLockItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = TransferWithLockItem{}
+	ipp.height = 0
+
+	transferWithLockItemProcessorPool.Put(ipp)
+
+	return
+}
+
+type TransferWithLockProcessor struct {
+	*mitumbase.BaseOperationProcessor
+}
+
+func NewTransferWithLockProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height mitumbase.Height,
+		getStateFunc mitumbase.GetStateFunc,
+		newPreProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc mitumbase.NewOperationProcessorProcessFunc,
+	) (mitumbase.OperationProcessor, error) {
+		e := util.StringError("failed to create new TransferWithLockProcessor")
+
+		nopp := transferWithLockProcessorPool.Get()
+		opp, ok := nopp.(*TransferWithLockProcessor)
+		if !ok {
+			return nil, e.Errorf("expected TransferWithLockProcessor, not %T", nopp)
+		}
+
+		b, err := mitumbase.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferWithLockProcessor) PreProcess(
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
+) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(TransferWithLockFact)
+	if !ok {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", TransferWithLockFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", fact.Sender(), cErr)), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := transferWithLockItemProcessorPool.Get()
+		ipc, ok := ip.(*TransferWithLockItemProcessor)
+		if !ok {
+			return nil, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMTypeMismatch.Errorf("expected TransferWithLockItemProcessor, not %T", ip)), nil
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err),
+			), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *TransferWithLockProcessor) Process( // nolint:dupl
+	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
+	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
+) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process TransferWithLock")
+
+	fact, ok := op.Fact().(TransferWithLockFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected TransferWithLockFact, not %T", op.Fact())
+	}
+
+	var sts []mitumbase.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := transferWithLockItemProcessorPool.Get()
+		ipc, ok := ip.(*TransferWithLockItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected TransferWithLockItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, mitumbase.NewBaseOperationProcessReasonError("failed to process TransferWithLockItem; %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	items := make([]CollectionItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	feeSts, err := payCollectionItemsFee(fact.Sender(), items, getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *TransferWithLockProcessor) Close() error {
+	transferWithLockProcessorPool.Put(opp)
+
+	return nil
+}
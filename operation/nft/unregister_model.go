@@ -0,0 +1,114 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	UnregisterModelFactHint = hint.MustNewHint("mitum-nft-unregister-model-operation-fact-v0.0.1")
+	UnregisterModelHint     = hint.MustNewHint("mitum-nft-unregister-model-operation-v0.0.1")
+)
+
+type UnregisterModelFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	currency currencytypes.CurrencyID
+}
+
+func NewUnregisterModelFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	currency currencytypes.CurrencyID,
+) UnregisterModelFact {
+	bf := mitumbase.NewBaseFact(UnregisterModelFactHint, token)
+
+	fact := UnregisterModelFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UnregisterModelFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UnregisterModelFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UnregisterModelFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UnregisterModelFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UnregisterModelFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UnregisterModelFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact UnregisterModelFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact UnregisterModelFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UnregisterModelFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type UnregisterModel struct {
+	common.BaseOperation
+}
+
+func NewUnregisterModel(fact UnregisterModelFact) (UnregisterModel, error) {
+	return UnregisterModel{BaseOperation: common.NewBaseOperation(UnregisterModelHint, fact)}, nil
+}
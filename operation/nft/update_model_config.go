@@ -24,6 +24,8 @@ type UpdateModelConfigFact struct {
 	royalty   types.PaymentParameter
 	uri       types.URI
 	whitelist []mitumbase.Address
+	metadata  types.CollectionMetadata
+	uriPolicy types.URIPolicy
 	currency  currencytypes.CurrencyID
 }
 
@@ -34,6 +36,8 @@ func NewUpdateModelConfigFact(
 	royalty types.PaymentParameter,
 	uri types.URI,
 	whitelist []mitumbase.Address,
+	metadata types.CollectionMetadata,
+	uriPolicy types.URIPolicy,
 	currency currencytypes.CurrencyID,
 ) UpdateModelConfigFact {
 	bf := mitumbase.NewBaseFact(UpdateModelConfigFactHint, token)
@@ -46,6 +50,8 @@ func NewUpdateModelConfigFact(
 		royalty:   royalty,
 		uri:       uri,
 		whitelist: whitelist,
+		metadata:  metadata,
+		uriPolicy: uriPolicy,
 		currency:  currency,
 	}
 	fact.SetHash(fact.GenerateHash())
@@ -75,6 +81,8 @@ func (fact UpdateModelConfigFact) IsValid(b []byte) error {
 		fact.name,
 		fact.royalty,
 		fact.uri,
+		fact.metadata,
+		fact.uriPolicy,
 		fact.currency,
 	); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
@@ -97,6 +105,10 @@ func (fact UpdateModelConfigFact) IsValid(b []byte) error {
 		founds[white.String()] = struct{}{}
 	}
 
+	if err := fact.uriPolicy.PermitsAll(fact.uri, fact.metadata.Image(), fact.metadata.Banner()); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -127,6 +139,8 @@ func (fact UpdateModelConfigFact) Bytes() []byte {
 		fact.uri.Bytes(),
 		fact.currency.Bytes(),
 		util.ConcatBytesSlice(as...),
+		fact.metadata.Bytes(),
+		fact.uriPolicy.Bytes(),
 	)
 }
 
@@ -158,6 +172,14 @@ func (fact UpdateModelConfigFact) Whitelist() []mitumbase.Address {
 	return fact.whitelist
 }
 
+func (fact UpdateModelConfigFact) Metadata() types.CollectionMetadata {
+	return fact.metadata
+}
+
+func (fact UpdateModelConfigFact) URIPolicy() types.URIPolicy {
+	return fact.uriPolicy
+}
+
 func (fact UpdateModelConfigFact) Currency() currencytypes.CurrencyID {
 	return fact.currency
 }
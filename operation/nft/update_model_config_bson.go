@@ -20,6 +20,8 @@ func (fact UpdateModelConfigFact) MarshalBSON() ([]byte, error) {
 			"royalty":          fact.royalty,
 			"uri":              fact.uri,
 			"minter_whitelist": fact.whitelist,
+			"metadata":         fact.metadata,
+			"uri_policy":       fact.uriPolicy,
 			"currency":         fact.currency,
 		})
 }
@@ -32,6 +34,8 @@ type UpdateModelConfigFactBSONUnmarshaler struct {
 	Royalty   uint     `bson:"royalty"`
 	URI       string   `bson:"uri"`
 	Whitelist []string `bson:"minter_whitelist"`
+	Metadata  bson.Raw `bson:"metadata"`
+	URIPolicy bson.Raw `bson:"uri_policy"`
 	Currency  string   `bson:"currency"`
 }
 
@@ -57,7 +61,7 @@ func (fact *UpdateModelConfigFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) er
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Name, uf.Royalty, uf.URI, uf.Whitelist, uf.Currency); err != nil {
+	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Name, uf.Royalty, uf.URI, uf.Whitelist, uf.Metadata, uf.URIPolicy, uf.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
 	}
 
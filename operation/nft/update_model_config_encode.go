@@ -15,6 +15,8 @@ func (fact *UpdateModelConfigFact) unpack(
 	ry uint,
 	uri string,
 	bws []string,
+	bmd []byte,
+	bup []byte,
 	cid string,
 ) error {
 	fact.currency = currencytypes.CurrencyID(cid)
@@ -52,5 +54,17 @@ func (fact *UpdateModelConfigFact) unpack(
 	}
 	fact.whitelist = whitelist
 
+	metadata, err := types.DecodeCollectionMetadata(enc, bmd)
+	if err != nil {
+		return err
+	}
+	fact.metadata = metadata
+
+	uriPolicy, err := types.DecodeURIPolicy(enc, bup)
+	if err != nil {
+		return err
+	}
+	fact.uriPolicy = uriPolicy
+
 	return nil
 }
@@ -1,6 +1,8 @@
 package nft
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum-nft/types"
@@ -17,6 +19,8 @@ type UpdateModelConfigFactJSONMarshaler struct {
 	Royalty   types.PaymentParameter   `json:"royalty"`
 	URI       types.URI                `json:"uri"`
 	Whitelist []mitumbase.Address      `json:"minter_whitelist"`
+	Metadata  types.CollectionMetadata `json:"metadata"`
+	URIPolicy types.URIPolicy          `json:"uri_policy"`
 	Currency  currencytypes.CurrencyID `json:"currency"`
 }
 
@@ -29,19 +33,23 @@ func (fact UpdateModelConfigFact) MarshalJSON() ([]byte, error) {
 		Royalty:               fact.royalty,
 		URI:                   fact.uri,
 		Whitelist:             fact.whitelist,
+		Metadata:              fact.metadata,
+		URIPolicy:             fact.uriPolicy,
 		Currency:              fact.currency,
 	})
 }
 
 type UpdateModelConfigFactJSONUnmarshaler struct {
 	mitumbase.BaseFactJSONUnmarshaler
-	Sender    string   `json:"sender"`
-	Contract  string   `json:"contract"`
-	Name      string   `json:"name"`
-	Royalty   uint     `json:"royalty"`
-	URI       string   `json:"uri"`
-	Whitelist []string `json:"minter_whitelist"`
-	Currency  string   `json:"currency"`
+	Sender    string          `json:"sender"`
+	Contract  string          `json:"contract"`
+	Name      string          `json:"name"`
+	Royalty   uint            `json:"royalty"`
+	URI       string          `json:"uri"`
+	Whitelist []string        `json:"minter_whitelist"`
+	Metadata  json.RawMessage `json:"metadata"`
+	URIPolicy json.RawMessage `json:"uri_policy"`
+	Currency  string          `json:"currency"`
 }
 
 func (fact *UpdateModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -52,7 +60,7 @@ func (fact *UpdateModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) err
 
 	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
 
-	if err := fact.unpack(enc, u.Sender, u.Contract, u.Name, u.Royalty, u.URI, u.Whitelist, u.Currency); err != nil {
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Name, u.Royalty, u.URI, u.Whitelist, u.Metadata, u.URIPolicy, u.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
 	}
 
@@ -12,7 +12,6 @@ import (
 	"github.com/ProtoconNet/mitum-currency/v3/state"
 	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
-	stateextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	"github.com/ProtoconNet/mitum2/base"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -65,6 +64,8 @@ func NewUpdateModelConfigProcessor() currencytypes.GetNewProcessor {
 func (opp *UpdateModelConfigProcessor) PreProcess(
 	ctx context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc,
 ) (context.Context, mitumbase.OperationProcessReasonError, error) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(UpdateModelConfigFact)
 	if !ok {
 		return ctx, mitumbase.NewBaseOperationProcessReasonError(
@@ -121,13 +122,6 @@ func (opp *UpdateModelConfigProcessor) PreProcess(
 				Errorf("%v", cErr)), nil
 	}
 
-	_, err := stateextension.CheckCAAuthFromState(cSt, fact.Sender())
-	if err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
-			common.ErrMPreProcess.
-				Errorf("%v", err)), nil
-	}
-
 	st, err := state.ExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CollectionKey), "design", getStateFunc)
 	if err != nil {
 		return nil, mitumbase.NewBaseOperationProcessReasonError(
@@ -149,13 +143,56 @@ func (opp *UpdateModelConfigProcessor) PreProcess(
 				Errorf("collection in contract account %v has already been deactivated ", fact.Contract())), nil
 	}
 
-	return ctx, nil, nil
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())), nil
+	}
+
+	roles := []types.Role{types.RoleAdmin}
+	if isMetadataOnlyUpdate(policy, fact) {
+		roles = append(roles, types.RoleMetadataEditor)
+	}
+
+	if err := checkCollectionAuth(cSt, fact.Contract(), fact.Sender(), getStateFunc, roles...); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if found, _ := state.CheckNotExistsState(statenft.NFTStateKey(fact.Contract(), statenft.CouncilKey), getStateFunc); found {
+		return nil, mitumbase.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("collection policy of contract account %v is governed by council, propose it instead", fact.Contract())), nil
+	}
+
+	if policy.Name().Normalize() == fact.Name().Normalize() {
+		return ctx, nil, nil
+	}
+
+	if err := checkCollectionName(fact.Name(), fact.Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	nctx, err := checkCollectionNameInBlock(ctx, fact.Name(), fact.Contract())
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	return nctx, nil, nil
 }
 
 func (opp *UpdateModelConfigProcessor) Process(
 	_ context.Context, op mitumbase.Operation, getStateFunc mitumbase.GetStateFunc) (
 	[]mitumbase.StateMergeValue, mitumbase.OperationProcessReasonError, error,
 ) {
+	getStateFunc = registeredStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process UpdateCollectionPolicy")
 	fact, ok := op.Fact().(UpdateModelConfigFact)
 	if !ok {
@@ -172,6 +209,11 @@ func (opp *UpdateModelConfigProcessor) Process(
 		return nil, mitumbase.NewBaseOperationProcessReasonError("collection design value not found, %v: %w", fact.Contract(), err), nil
 	}
 
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("expected %T, not %T", types.CollectionPolicy{}, design.Policy()), nil
+	}
+
 	var sts []mitumbase.StateMergeValue
 	whitelist := fact.Whitelist()
 	for _, white := range whitelist {
@@ -187,10 +229,16 @@ func (opp *UpdateModelConfigProcessor) Process(
 		design.Contract(),
 		design.Creator(),
 		design.Active(),
-		types.NewCollectionPolicy(fact.name, fact.royalty, fact.uri, fact.whitelist),
+		types.NewCollectionPolicy(fact.name, fact.royalty, fact.uri, fact.whitelist, policy.Clawback(), policy.UniqueHash(), fact.metadata, fact.uriPolicy),
 	)
 	sts = append(sts, state.NewStateMergeValue(statenft.NFTStateKey(fact.contract, statenft.CollectionKey), statenft.NewCollectionStateValue(de)))
 
+	nsts, err := claimCollectionName(policy.Name(), fact.Name(), fact.Contract(), getStateFunc)
+	if err != nil {
+		return nil, mitumbase.NewBaseOperationProcessReasonError("failed to claim collection name, %v: %w", fact.Name(), err), nil
+	}
+	sts = append(sts, nsts...)
+
 	currencyPolicy, err := state.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
 	if err != nil {
 		return nil, mitumbase.NewBaseOperationProcessReasonError("currency not found, %v: %w", fact.Currency(), err), nil
@@ -277,3 +325,13 @@ func (opp *UpdateModelConfigProcessor) Close() error {
 
 	return nil
 }
+
+// isMetadataOnlyUpdate reports whether fact keeps every field of policy except
+// the collection metadata, which the metadata editor is allowed to change.
+func isMetadataOnlyUpdate(policy types.CollectionPolicy, fact UpdateModelConfigFact) bool {
+	return policy.Equal(
+		types.NewCollectionPolicy(
+			fact.Name(), fact.Royalty(), fact.URI(), fact.Whitelist(), policy.Clawback(), policy.UniqueHash(), policy.Metadata(), fact.URIPolicy(),
+		),
+	)
+}
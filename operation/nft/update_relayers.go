@@ -0,0 +1,140 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	UpdateRelayersFactHint = hint.MustNewHint("mitum-nft-update-relayers-operation-fact-v0.0.1")
+	UpdateRelayersHint     = hint.MustNewHint("mitum-nft-update-relayers-operation-v0.0.1")
+)
+
+type UpdateRelayersFact struct {
+	mitumbase.BaseFact
+	sender    mitumbase.Address
+	contract  mitumbase.Address
+	keys      []mitumbase.Publickey
+	threshold uint
+	currency  currencytypes.CurrencyID
+}
+
+func NewUpdateRelayersFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	keys []mitumbase.Publickey,
+	threshold uint,
+	currency currencytypes.CurrencyID,
+) UpdateRelayersFact {
+	bf := mitumbase.NewBaseFact(UpdateRelayersFactHint, token)
+
+	fact := UpdateRelayersFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		keys:      keys,
+		threshold: threshold,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateRelayersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := types.NewRelayerSet(fact.keys, fact.threshold).IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateRelayersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateRelayersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateRelayersFact) Bytes() []byte {
+	ks := make([][]byte, len(fact.keys))
+	for i, k := range fact.keys {
+		ks[i] = k.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(ks...),
+		util.UintToBytes(fact.threshold),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateRelayersFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateRelayersFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact UpdateRelayersFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact UpdateRelayersFact) Keys() []mitumbase.Publickey {
+	return fact.keys
+}
+
+func (fact UpdateRelayersFact) Threshold() uint {
+	return fact.threshold
+}
+
+func (fact UpdateRelayersFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateRelayersFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type UpdateRelayers struct {
+	common.BaseOperation
+}
+
+func NewUpdateRelayers(fact UpdateRelayersFact) (UpdateRelayers, error) {
+	return UpdateRelayers{BaseOperation: common.NewBaseOperation(UpdateRelayersHint, fact)}, nil
+}
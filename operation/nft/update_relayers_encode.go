@@ -0,0 +1,43 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateRelayersFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	bks []string,
+	th uint,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.threshold = th
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	keys := make([]mitumbase.Publickey, len(bks))
+	for i, bk := range bks {
+		k, err := mitumbase.DecodePublickeyFromString(bk, enc)
+		if err != nil {
+			return err
+		}
+		keys[i] = k
+	}
+	fact.keys = keys
+
+	return nil
+}
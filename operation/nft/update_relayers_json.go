@@ -0,0 +1,74 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type UpdateRelayersFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender    mitumbase.Address        `json:"sender"`
+	Contract  mitumbase.Address        `json:"contract"`
+	Keys      []mitumbase.Publickey    `json:"keys"`
+	Threshold uint                     `json:"threshold"`
+	Currency  currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateRelayersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateRelayersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Keys:                  fact.keys,
+		Threshold:             fact.threshold,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateRelayersFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender    string   `json:"sender"`
+	Contract  string   `json:"contract"`
+	Keys      []string `json:"keys"`
+	Threshold uint     `json:"threshold"`
+	Currency  string   `json:"currency"`
+}
+
+func (fact *UpdateRelayersFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u UpdateRelayersFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Keys, u.Threshold, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type UpdateRelayersMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateRelayers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateRelayersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateRelayers) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RestrictionListAdd    = RestrictionListAction("add")
+	RestrictionListRemove = RestrictionListAction("remove")
+)
+
+type RestrictionListAction string
+
+func (action RestrictionListAction) IsValid([]byte) error {
+	if !(action == RestrictionListAdd || action == RestrictionListRemove) {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong restriction list action, %v", action))
+	}
+
+	return nil
+}
+
+func (action RestrictionListAction) Bytes() []byte {
+	return []byte(action)
+}
+
+func (action RestrictionListAction) String() string {
+	return string(action)
+}
+
+var MaxRestrictionAccounts = 100
+
+var (
+	UpdateRestrictionListFactHint = hint.MustNewHint("mitum-nft-update-restriction-list-operation-fact-v0.0.1")
+	UpdateRestrictionListHint     = hint.MustNewHint("mitum-nft-update-restriction-list-operation-v0.0.1")
+)
+
+type UpdateRestrictionListFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	action   RestrictionListAction
+	accounts []mitumbase.Address
+	currency currencytypes.CurrencyID
+}
+
+func NewUpdateRestrictionListFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	action RestrictionListAction,
+	accounts []mitumbase.Address,
+	currency currencytypes.CurrencyID,
+) UpdateRestrictionListFact {
+	bf := mitumbase.NewBaseFact(UpdateRestrictionListFactHint, token)
+
+	fact := UpdateRestrictionListFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		action:   action,
+		accounts: accounts,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateRestrictionListFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.action,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := len(fact.accounts); l < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty accounts")))
+	} else if l > MaxRestrictionAccounts {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("accounts over allowed, %d > %d", l, MaxRestrictionAccounts)))
+	}
+
+	founds := map[string]struct{}{}
+	for _, acc := range fact.accounts {
+		if err := acc.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if acc.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract", acc)))
+		}
+
+		if _, found := founds[acc.String()]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("account %v", acc)))
+		}
+
+		founds[acc.String()] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateRestrictionListFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateRestrictionListFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateRestrictionListFact) Bytes() []byte {
+	as := make([][]byte, len(fact.accounts))
+	for i, acc := range fact.accounts {
+		as[i] = acc.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.action.Bytes(),
+		util.ConcatBytesSlice(as...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateRestrictionListFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateRestrictionListFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact UpdateRestrictionListFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact UpdateRestrictionListFact) Action() RestrictionListAction {
+	return fact.action
+}
+
+func (fact UpdateRestrictionListFact) Accounts() []mitumbase.Address {
+	return fact.accounts
+}
+
+func (fact UpdateRestrictionListFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateRestrictionListFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type UpdateRestrictionList struct {
+	common.BaseOperation
+}
+
+func NewUpdateRestrictionList(fact UpdateRestrictionListFact) (UpdateRestrictionList, error) {
+	return UpdateRestrictionList{BaseOperation: common.NewBaseOperation(UpdateRestrictionListHint, fact)}, nil
+}
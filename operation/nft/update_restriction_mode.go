@@ -0,0 +1,124 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	UpdateRestrictionModeFactHint = hint.MustNewHint("mitum-nft-update-restriction-mode-operation-fact-v0.0.1")
+	UpdateRestrictionModeHint     = hint.MustNewHint("mitum-nft-update-restriction-mode-operation-v0.0.1")
+)
+
+type UpdateRestrictionModeFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	mode     types.RestrictionMode
+	currency currencytypes.CurrencyID
+}
+
+func NewUpdateRestrictionModeFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	mode types.RestrictionMode,
+	currency currencytypes.CurrencyID,
+) UpdateRestrictionModeFact {
+	bf := mitumbase.NewBaseFact(UpdateRestrictionModeFactHint, token)
+
+	fact := UpdateRestrictionModeFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		mode:     mode,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateRestrictionModeFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.mode,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateRestrictionModeFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateRestrictionModeFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateRestrictionModeFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.mode.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateRestrictionModeFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateRestrictionModeFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact UpdateRestrictionModeFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact UpdateRestrictionModeFact) Mode() types.RestrictionMode {
+	return fact.mode
+}
+
+func (fact UpdateRestrictionModeFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateRestrictionModeFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type UpdateRestrictionMode struct {
+	common.BaseOperation
+}
+
+func NewUpdateRestrictionMode(fact UpdateRestrictionModeFact) (UpdateRestrictionMode, error) {
+	return UpdateRestrictionMode{BaseOperation: common.NewBaseOperation(UpdateRestrictionModeHint, fact)}, nil
+}
@@ -0,0 +1,33 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateRestrictionModeFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	md string,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.mode = types.RestrictionMode(md)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type UpdateRestrictionModeFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender   mitumbase.Address        `json:"sender"`
+	Contract mitumbase.Address        `json:"contract"`
+	Mode     types.RestrictionMode    `json:"mode"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateRestrictionModeFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateRestrictionModeFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Mode:                  fact.mode,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateRestrictionModeFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	Mode     string `json:"mode"`
+	Currency string `json:"currency"`
+}
+
+func (fact *UpdateRestrictionModeFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u UpdateRestrictionModeFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Mode, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type UpdateRestrictionModeMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateRestrictionMode) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateRestrictionModeMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateRestrictionMode) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
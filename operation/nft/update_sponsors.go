@@ -0,0 +1,186 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	SponsorListAdd    = SponsorListAction("add")
+	SponsorListRemove = SponsorListAction("remove")
+)
+
+type SponsorListAction string
+
+func (action SponsorListAction) IsValid([]byte) error {
+	if !(action == SponsorListAdd || action == SponsorListRemove) {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong sponsor list action, %v", action))
+	}
+
+	return nil
+}
+
+func (action SponsorListAction) Bytes() []byte {
+	return []byte(action)
+}
+
+func (action SponsorListAction) String() string {
+	return string(action)
+}
+
+var MaxSponsorAccounts = 100
+
+var (
+	UpdateSponsorsFactHint = hint.MustNewHint("mitum-nft-update-sponsors-operation-fact-v0.0.1")
+	UpdateSponsorsHint     = hint.MustNewHint("mitum-nft-update-sponsors-operation-v0.0.1")
+)
+
+type UpdateSponsorsFact struct {
+	mitumbase.BaseFact
+	sender   mitumbase.Address
+	contract mitumbase.Address
+	action   SponsorListAction
+	accounts []mitumbase.Address
+	currency currencytypes.CurrencyID
+}
+
+func NewUpdateSponsorsFact(
+	token []byte,
+	sender, contract mitumbase.Address,
+	action SponsorListAction,
+	accounts []mitumbase.Address,
+	currency currencytypes.CurrencyID,
+) UpdateSponsorsFact {
+	bf := mitumbase.NewBaseFact(UpdateSponsorsFactHint, token)
+
+	fact := UpdateSponsorsFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		action:   action,
+		accounts: accounts,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateSponsorsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.action,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := len(fact.accounts); l < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty accounts")))
+	} else if l > MaxSponsorAccounts {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("accounts over allowed, %d > %d", l, MaxSponsorAccounts)))
+	}
+
+	founds := map[string]struct{}{}
+	for _, acc := range fact.accounts {
+		if err := acc.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if acc.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract", acc)))
+		}
+
+		if _, found := founds[acc.String()]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("account %v", acc)))
+		}
+
+		founds[acc.String()] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateSponsorsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateSponsorsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateSponsorsFact) Bytes() []byte {
+	as := make([][]byte, len(fact.accounts))
+	for i, acc := range fact.accounts {
+		as[i] = acc.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.action.Bytes(),
+		util.ConcatBytesSlice(as...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateSponsorsFact) Token() mitumbase.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateSponsorsFact) Sender() mitumbase.Address {
+	return fact.sender
+}
+
+func (fact UpdateSponsorsFact) Contract() mitumbase.Address {
+	return fact.contract
+}
+
+func (fact UpdateSponsorsFact) Action() SponsorListAction {
+	return fact.action
+}
+
+func (fact UpdateSponsorsFact) Accounts() []mitumbase.Address {
+	return fact.accounts
+}
+
+func (fact UpdateSponsorsFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateSponsorsFact) Addresses() ([]mitumbase.Address, error) {
+	as := make([]mitumbase.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type UpdateSponsors struct {
+	common.BaseOperation
+}
+
+func NewUpdateSponsors(fact UpdateSponsorsFact) (UpdateSponsors, error) {
+	return UpdateSponsors{BaseOperation: common.NewBaseOperation(UpdateSponsorsHint, fact)}, nil
+}
@@ -0,0 +1,43 @@
+package nft
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateSponsorsFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	ct string,
+	ac string,
+	acs []string,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+	fact.action = SponsorListAction(ac)
+
+	sender, err := mitumbase.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := mitumbase.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	accounts := make([]mitumbase.Address, len(acs))
+	for i, acc := range acs {
+		a, err := mitumbase.DecodeAddress(acc, enc)
+		if err != nil {
+			return err
+		}
+		accounts[i] = a
+	}
+	fact.accounts = accounts
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package nft
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type UpdateSponsorsFactJSONMarshaler struct {
+	mitumbase.BaseFactJSONMarshaler
+	Sender   mitumbase.Address        `json:"sender"`
+	Contract mitumbase.Address        `json:"contract"`
+	Action   SponsorListAction        `json:"action"`
+	Accounts []mitumbase.Address      `json:"accounts"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateSponsorsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateSponsorsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Action:                fact.action,
+		Accounts:              fact.accounts,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateSponsorsFactJSONUnmarshaler struct {
+	mitumbase.BaseFactJSONUnmarshaler
+	Sender   string   `json:"sender"`
+	Contract string   `json:"contract"`
+	Action   string   `json:"action"`
+	Accounts []string `json:"accounts"`
+	Currency string   `json:"currency"`
+}
+
+func (fact *UpdateSponsorsFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u UpdateSponsorsFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Action, u.Accounts, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type UpdateSponsorsMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateSponsors) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateSponsorsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateSponsors) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
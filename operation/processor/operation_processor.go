@@ -88,6 +88,37 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 		}
 		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
 		duplicationTypeContractID = currencyprocessor.DuplicationKey(fact.Contract().String(), DuplicationTypeContract)
+	case nft.UnregisterModel:
+		fact, ok := t.Fact().(nft.UnregisterModelFact)
+		if !ok {
+			return errors.Errorf("expected UnregisterModelFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeContractID = currencyprocessor.DuplicationKey(fact.Contract().String(), DuplicationTypeContract)
+	case nft.Migrate:
+		fact, ok := t.Fact().(nft.MigrateFact)
+		if !ok {
+			return errors.Errorf("expected MigrateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.UpdateRelayers:
+		fact, ok := t.Fact().(nft.UpdateRelayersFact)
+		if !ok {
+			return errors.Errorf("expected UpdateRelayersFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.BridgeLock:
+		fact, ok := t.Fact().(nft.BridgeLockFact)
+		if !ok {
+			return errors.Errorf("expected BridgeLockFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.BridgeRelease:
+		fact, ok := t.Fact().(nft.BridgeReleaseFact)
+		if !ok {
+			return errors.Errorf("expected BridgeReleaseFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
 	case nft.UpdateModelConfig:
 		fact, ok := t.Fact().(nft.UpdateModelConfigFact)
 		if !ok {
@@ -124,6 +155,78 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 			return errors.Errorf("expected SignFact, not %T", t.Fact())
 		}
 		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.TransferWithLock:
+		fact, ok := t.Fact().(nft.TransferWithLockFact)
+		if !ok {
+			return errors.Errorf("expected TransferWithLockFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.Claim:
+		fact, ok := t.Fact().(nft.ClaimFact)
+		if !ok {
+			return errors.Errorf("expected ClaimFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.ForceTransfer:
+		fact, ok := t.Fact().(nft.ForceTransferFact)
+		if !ok {
+			return errors.Errorf("expected ForceTransferFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.UpdateRestrictionMode:
+		fact, ok := t.Fact().(nft.UpdateRestrictionModeFact)
+		if !ok {
+			return errors.Errorf("expected UpdateRestrictionModeFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.UpdateRestrictionList:
+		fact, ok := t.Fact().(nft.UpdateRestrictionListFact)
+		if !ok {
+			return errors.Errorf("expected UpdateRestrictionListFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.UpdateSponsors:
+		fact, ok := t.Fact().(nft.UpdateSponsorsFact)
+		if !ok {
+			return errors.Errorf("expected UpdateSponsorsFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.GrantRole:
+		fact, ok := t.Fact().(nft.GrantRoleFact)
+		if !ok {
+			return errors.Errorf("expected GrantRoleFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.RevokeRole:
+		fact, ok := t.Fact().(nft.RevokeRoleFact)
+		if !ok {
+			return errors.Errorf("expected RevokeRoleFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.RegisterCouncil:
+		fact, ok := t.Fact().(nft.RegisterCouncilFact)
+		if !ok {
+			return errors.Errorf("expected RegisterCouncilFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.ProposeModelConfig:
+		fact, ok := t.Fact().(nft.ProposeModelConfigFact)
+		if !ok {
+			return errors.Errorf("expected ProposeModelConfigFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.ProposeCollectionAction:
+		fact, ok := t.Fact().(nft.ProposeCollectionActionFact)
+		if !ok {
+			return errors.Errorf("expected ProposeCollectionActionFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case nft.ApproveProposal:
+		fact, ok := t.Fact().(nft.ApproveProposalFact)
+		if !ok {
+			return errors.Errorf("expected ApproveProposalFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
 	default:
 		return nil
 	}
@@ -195,12 +298,29 @@ func GetNewProcessor(opr *currencyprocessor.OperationProcessor, op mitumbase.Ope
 		currency.UpdateCurrency,
 		currency.Mint,
 		nft.RegisterModel,
+		nft.UnregisterModel,
+		nft.Migrate,
+		nft.UpdateRelayers,
+		nft.BridgeLock,
+		nft.BridgeRelease,
 		nft.UpdateModelConfig,
 		nft.Mint,
 		nft.Transfer,
 		nft.ApproveAll,
 		nft.Approve,
-		nft.AddSignature:
+		nft.AddSignature,
+		nft.TransferWithLock,
+		nft.Claim,
+		nft.ForceTransfer,
+		nft.UpdateRestrictionMode,
+		nft.UpdateRestrictionList,
+		nft.UpdateSponsors,
+		nft.GrantRole,
+		nft.RevokeRole,
+		nft.RegisterCouncil,
+		nft.ProposeModelConfig,
+		nft.ProposeCollectionAction,
+		nft.ApproveProposal:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return nil, false, nil
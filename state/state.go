@@ -1,6 +1,8 @@
 package state
 
 import (
+	"sync"
+
 	"github.com/ProtoconNet/mitum-nft/types"
 
 	mitumbase "github.com/ProtoconNet/mitum2/base"
@@ -206,3 +208,768 @@ func StateOperatorsBookValue(st mitumbase.State) (*types.AllApprovedBook, error)
 
 	return &ob.Operators, nil
 }
+
+var NFTLockStateValueHint = hint.MustNewHint("nft-lock-state-value-v0.0.1")
+
+type NFTLockStateValue struct {
+	hint.BaseHinter
+	Lock types.NFTLock
+}
+
+func NewNFTLockStateValue(lock types.NFTLock) NFTLockStateValue {
+	return NFTLockStateValue{
+		BaseHinter: hint.NewBaseHinter(NFTLockStateValueHint),
+		Lock:       lock,
+	}
+}
+
+func (ls NFTLockStateValue) Hint() hint.Hint {
+	return ls.BaseHinter.Hint()
+}
+
+func (ls NFTLockStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid NFTLockStateValue")
+
+	if err := ls.BaseHinter.IsValid(NFTLockStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ls.Lock.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ls NFTLockStateValue) HashBytes() []byte {
+	return ls.Lock.Bytes()
+}
+
+func StateNFTLockValue(st mitumbase.State) (*types.NFTLock, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("nft lock not found in State")
+	}
+
+	ls, ok := v.(NFTLockStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid nft lock value found, %T", v)
+	}
+
+	return &ls.Lock, nil
+}
+
+var RestrictionModeStateValueHint = hint.MustNewHint("restriction-mode-state-value-v0.0.1")
+
+type RestrictionModeStateValue struct {
+	hint.BaseHinter
+	Mode types.RestrictionMode
+}
+
+func NewRestrictionModeStateValue(mode types.RestrictionMode) RestrictionModeStateValue {
+	return RestrictionModeStateValue{
+		BaseHinter: hint.NewBaseHinter(RestrictionModeStateValueHint),
+		Mode:       mode,
+	}
+}
+
+func (rs RestrictionModeStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RestrictionModeStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RestrictionModeStateValue")
+
+	if err := rs.BaseHinter.IsValid(RestrictionModeStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := rs.Mode.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rs RestrictionModeStateValue) HashBytes() []byte {
+	return rs.Mode.Bytes()
+}
+
+func StateRestrictionModeValue(st mitumbase.State) (types.RestrictionMode, error) {
+	v := st.Value()
+	if v == nil {
+		return "", util.ErrNotFound.Errorf("restriction mode not found in State")
+	}
+
+	rs, ok := v.(RestrictionModeStateValue)
+	if !ok {
+		return "", errors.Errorf("invalid restriction mode value found, %T", v)
+	}
+
+	return rs.Mode, nil
+}
+
+var RestrictionStateValueHint = hint.MustNewHint("restriction-state-value-v0.0.1")
+
+// RestrictionStateValue keeps whether an account is in the restriction list of a collection.
+// Each account has its own state, so the list is not capped.
+type RestrictionStateValue struct {
+	hint.BaseHinter
+	Listed bool
+}
+
+func NewRestrictionStateValue(listed bool) RestrictionStateValue {
+	return RestrictionStateValue{
+		BaseHinter: hint.NewBaseHinter(RestrictionStateValueHint),
+		Listed:     listed,
+	}
+}
+
+func (rs RestrictionStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RestrictionStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RestrictionStateValue")
+
+	if err := rs.BaseHinter.IsValid(RestrictionStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rs RestrictionStateValue) HashBytes() []byte {
+	if rs.Listed {
+		return []byte{1}
+	}
+
+	return []byte{0}
+}
+
+func StateRestrictionValue(st mitumbase.State) (bool, error) {
+	v := st.Value()
+	if v == nil {
+		return false, util.ErrNotFound.Errorf("restriction not found in State")
+	}
+
+	rs, ok := v.(RestrictionStateValue)
+	if !ok {
+		return false, errors.Errorf("invalid restriction value found, %T", v)
+	}
+
+	return rs.Listed, nil
+}
+
+var SponsorStateValueHint = hint.MustNewHint("sponsor-state-value-v0.0.1")
+
+// SponsorStateValue keeps whether an account is allowed to pay fees of operations
+// on a collection on behalf of their senders.
+type SponsorStateValue struct {
+	hint.BaseHinter
+	Allowed bool
+}
+
+func NewSponsorStateValue(allowed bool) SponsorStateValue {
+	return SponsorStateValue{
+		BaseHinter: hint.NewBaseHinter(SponsorStateValueHint),
+		Allowed:    allowed,
+	}
+}
+
+func (ss SponsorStateValue) Hint() hint.Hint {
+	return ss.BaseHinter.Hint()
+}
+
+func (ss SponsorStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid SponsorStateValue")
+
+	if err := ss.BaseHinter.IsValid(SponsorStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ss SponsorStateValue) HashBytes() []byte {
+	if ss.Allowed {
+		return []byte{1}
+	}
+
+	return []byte{0}
+}
+
+func StateSponsorValue(st mitumbase.State) (bool, error) {
+	v := st.Value()
+	if v == nil {
+		return false, util.ErrNotFound.Errorf("sponsor not found in State")
+	}
+
+	ss, ok := v.(SponsorStateValue)
+	if !ok {
+		return false, errors.Errorf("invalid sponsor value found, %T", v)
+	}
+
+	return ss.Allowed, nil
+}
+
+var RolesStateValueHint = hint.MustNewHint("roles-state-value-v0.0.1")
+
+// RolesStateValue keeps the roles granted to an account in a collection.
+type RolesStateValue struct {
+	hint.BaseHinter
+	Roles []types.Role
+}
+
+func NewRolesStateValue(roles []types.Role) RolesStateValue {
+	return RolesStateValue{
+		BaseHinter: hint.NewBaseHinter(RolesStateValueHint),
+		Roles:      roles,
+	}
+}
+
+func (rs RolesStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RolesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RolesStateValue")
+
+	if err := rs.BaseHinter.IsValid(RolesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[types.Role]struct{}{}
+	for _, r := range rs.Roles {
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[r]; found {
+			return e.Wrap(errors.Errorf("duplicated role, %v", r))
+		}
+		founds[r] = struct{}{}
+	}
+
+	return nil
+}
+
+func (rs RolesStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(rs.Roles))
+	for i, r := range rs.Roles {
+		bs[i] = r.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateRolesValue(st mitumbase.State) ([]types.Role, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("roles not found in State")
+	}
+
+	rs, ok := v.(RolesStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid roles value found, %T", v)
+	}
+
+	return rs.Roles, nil
+}
+
+var CouncilStateValueHint = hint.MustNewHint("council-state-value-v0.0.1")
+
+type CouncilStateValue struct {
+	hint.BaseHinter
+	Council types.Council
+}
+
+func NewCouncilStateValue(council types.Council) CouncilStateValue {
+	return CouncilStateValue{
+		BaseHinter: hint.NewBaseHinter(CouncilStateValueHint),
+		Council:    council,
+	}
+}
+
+func (cs CouncilStateValue) Hint() hint.Hint {
+	return cs.BaseHinter.Hint()
+}
+
+func (cs CouncilStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid CouncilStateValue")
+
+	if err := cs.BaseHinter.IsValid(CouncilStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := cs.Council.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cs CouncilStateValue) HashBytes() []byte {
+	return cs.Council.Bytes()
+}
+
+func StateCouncilValue(st mitumbase.State) (*types.Council, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("council not found in State")
+	}
+
+	cs, ok := v.(CouncilStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid council value found, %T", v)
+	}
+
+	return &cs.Council, nil
+}
+
+var ProposalStateValueHint = hint.MustNewHint("proposal-state-value-v0.0.1")
+
+type ProposalStateValue struct {
+	hint.BaseHinter
+	Proposal types.Proposal
+}
+
+func NewProposalStateValue(proposal types.Proposal) ProposalStateValue {
+	return ProposalStateValue{
+		BaseHinter: hint.NewBaseHinter(ProposalStateValueHint),
+		Proposal:   proposal,
+	}
+}
+
+func (ps ProposalStateValue) Hint() hint.Hint {
+	return ps.BaseHinter.Hint()
+}
+
+func (ps ProposalStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ProposalStateValue")
+
+	if err := ps.BaseHinter.IsValid(ProposalStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ps.Proposal.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ps ProposalStateValue) HashBytes() []byte {
+	return ps.Proposal.Bytes()
+}
+
+func StateProposalValue(st mitumbase.State) (*types.Proposal, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("proposal not found in State")
+	}
+
+	ps, ok := v.(ProposalStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid proposal value found, %T", v)
+	}
+
+	return &ps.Proposal, nil
+}
+
+var NFTHashStateValueHint = hint.MustNewHint("nft-hash-state-value-v0.0.1")
+
+// NFTHashStateValue keeps the index of nft minted with a hash in a collection
+// with unique hash policy.
+type NFTHashStateValue struct {
+	hint.BaseHinter
+	Hash  types.NFTHash
+	Index uint64
+}
+
+func NewNFTHashStateValue(hash types.NFTHash, idx uint64) NFTHashStateValue {
+	return NFTHashStateValue{
+		BaseHinter: hint.NewBaseHinter(NFTHashStateValueHint),
+		Hash:       hash,
+		Index:      idx,
+	}
+}
+
+func (hs NFTHashStateValue) Hint() hint.Hint {
+	return hs.BaseHinter.Hint()
+}
+
+func (hs NFTHashStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid NFTHashStateValue")
+
+	if err := hs.BaseHinter.IsValid(NFTHashStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := hs.Hash.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (hs NFTHashStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(hs.Hash.Bytes(), util.Uint64ToBytes(hs.Index))
+}
+
+func StateNFTHashValue(st mitumbase.State) (*NFTHashStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("nft hash not found in State")
+	}
+
+	hs, ok := v.(NFTHashStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid nft hash value found, %T", v)
+	}
+
+	return &hs, nil
+}
+
+var CollectionNameStateValueHint = hint.MustNewHint("collection-name-state-value-v0.0.1")
+
+// CollectionNameStateValue keeps the contract account which claims a collection name.
+// Contract is nil when the name has been released by a rename.
+type CollectionNameStateValue struct {
+	hint.BaseHinter
+	Name     types.CollectionName
+	Contract mitumbase.Address
+}
+
+func NewCollectionNameStateValue(name types.CollectionName, contract mitumbase.Address) CollectionNameStateValue {
+	return CollectionNameStateValue{
+		BaseHinter: hint.NewBaseHinter(CollectionNameStateValueHint),
+		Name:       name,
+		Contract:   contract,
+	}
+}
+
+func (cs CollectionNameStateValue) Hint() hint.Hint {
+	return cs.BaseHinter.Hint()
+}
+
+func (cs CollectionNameStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid CollectionNameStateValue")
+
+	if err := cs.BaseHinter.IsValid(CollectionNameStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := cs.Name.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if cs.Contract != nil {
+		if err := cs.Contract.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (cs CollectionNameStateValue) HashBytes() []byte {
+	var ca []byte
+	if cs.Contract != nil {
+		ca = cs.Contract.Bytes()
+	}
+
+	return util.ConcatBytesSlice(cs.Name.Bytes(), ca)
+}
+
+// IsClaimedByOther reports whether the name is claimed by a contract account other than contract.
+func (cs CollectionNameStateValue) IsClaimedByOther(contract mitumbase.Address) bool {
+	return cs.Contract != nil && !cs.Contract.Equal(contract)
+}
+
+func StateCollectionNameValue(st mitumbase.State) (*CollectionNameStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("collection name not found in State")
+	}
+
+	cs, ok := v.(CollectionNameStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid collection name value found, %T", v)
+	}
+
+	return &cs, nil
+}
+
+var RelayerSetStateValueHint = hint.MustNewHint("relayer-set-state-value-v0.0.1")
+
+type RelayerSetStateValue struct {
+	hint.BaseHinter
+	Relayers types.RelayerSet
+}
+
+func NewRelayerSetStateValue(relayers types.RelayerSet) RelayerSetStateValue {
+	return RelayerSetStateValue{
+		BaseHinter: hint.NewBaseHinter(RelayerSetStateValueHint),
+		Relayers:   relayers,
+	}
+}
+
+func (rs RelayerSetStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RelayerSetStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RelayerSetStateValue")
+
+	if err := rs.BaseHinter.IsValid(RelayerSetStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := rs.Relayers.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rs RelayerSetStateValue) HashBytes() []byte {
+	return rs.Relayers.Bytes()
+}
+
+func StateRelayerSetValue(st mitumbase.State) (*types.RelayerSet, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("relayer set not found in State")
+	}
+
+	rs, ok := v.(RelayerSetStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid relayer set value found, %T", v)
+	}
+
+	return &rs.Relayers, nil
+}
+
+var BridgeLockStateValueHint = hint.MustNewHint("bridge-lock-state-value-v0.0.1")
+
+type BridgeLockStateValue struct {
+	hint.BaseHinter
+	Lock types.BridgeLock
+}
+
+func NewBridgeLockStateValue(lock types.BridgeLock) BridgeLockStateValue {
+	return BridgeLockStateValue{
+		BaseHinter: hint.NewBaseHinter(BridgeLockStateValueHint),
+		Lock:       lock,
+	}
+}
+
+func (ls BridgeLockStateValue) Hint() hint.Hint {
+	return ls.BaseHinter.Hint()
+}
+
+func (ls BridgeLockStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid BridgeLockStateValue")
+
+	if err := ls.BaseHinter.IsValid(BridgeLockStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ls.Lock.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ls BridgeLockStateValue) HashBytes() []byte {
+	return ls.Lock.Bytes()
+}
+
+func StateBridgeLockValue(st mitumbase.State) (*types.BridgeLock, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("bridge lock not found in State")
+	}
+
+	ls, ok := v.(BridgeLockStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid bridge lock value found, %T", v)
+	}
+
+	return &ls.Lock, nil
+}
+
+var RegistrationStateValueHint = hint.MustNewHint("registration-state-value-v0.0.1")
+
+// RegistrationStateValue keeps the height at which the collection was registered
+// most recently. States kept per registration which are written before this
+// height belong to the previous registration and are ignored.
+type RegistrationStateValue struct {
+	hint.BaseHinter
+	Height mitumbase.Height
+}
+
+func NewRegistrationStateValue(height mitumbase.Height) RegistrationStateValue {
+	return RegistrationStateValue{
+		BaseHinter: hint.NewBaseHinter(RegistrationStateValueHint),
+		Height:     height,
+	}
+}
+
+func (rs RegistrationStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RegistrationStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RegistrationStateValue")
+
+	if err := rs.BaseHinter.IsValid(RegistrationStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := rs.Height.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rs RegistrationStateValue) HashBytes() []byte {
+	return rs.Height.Bytes()
+}
+
+func StateRegistrationValue(st mitumbase.State) (mitumbase.Height, error) {
+	v := st.Value()
+	if v == nil {
+		return mitumbase.NilHeight, util.ErrNotFound.Errorf("registration not found in State")
+	}
+
+	rs, ok := v.(RegistrationStateValue)
+	if !ok {
+		return mitumbase.NilHeight, errors.Errorf("invalid registration value found, %T", v)
+	}
+
+	return rs.Height, nil
+}
+
+var SupplyStateValueHint = hint.MustNewHint("supply-state-value-v0.0.1")
+
+// SupplyStateValue keeps the number of active nfts in a collection, so whether
+// a collection is empty can be checked without scanning its nfts.
+type SupplyStateValue struct {
+	hint.BaseHinter
+	Supply uint64
+}
+
+func NewSupplyStateValue(supply uint64) SupplyStateValue {
+	return SupplyStateValue{
+		BaseHinter: hint.NewBaseHinter(SupplyStateValueHint),
+		Supply:     supply,
+	}
+}
+
+func (ss SupplyStateValue) Hint() hint.Hint {
+	return ss.BaseHinter.Hint()
+}
+
+func (ss SupplyStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid SupplyStateValue")
+
+	if err := ss.BaseHinter.IsValid(SupplyStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ss SupplyStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(ss.Supply)
+}
+
+func StateSupplyValue(st mitumbase.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("supply not found in State")
+	}
+
+	ss, ok := v.(SupplyStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid supply value found, %T", v)
+	}
+
+	return ss.Supply, nil
+}
+
+// SupplyDeltaStateValue changes the supply of collection by Delta. It is not
+// stored but merged by SupplyStateValueMerger, so the operations changing the
+// supply of the same collection in a block do not overwrite each other.
+type SupplyDeltaStateValue struct {
+	Delta int64
+}
+
+func NewSupplyDeltaStateValue(delta int64) SupplyDeltaStateValue {
+	return SupplyDeltaStateValue{Delta: delta}
+}
+
+func (sd SupplyDeltaStateValue) IsValid([]byte) error {
+	if sd.Delta == 0 {
+		return util.ErrInvalid.Errorf("zero supply delta")
+	}
+
+	return nil
+}
+
+func (sd SupplyDeltaStateValue) HashBytes() []byte {
+	return util.Int64ToBytes(sd.Delta)
+}
+
+type SupplyStateValueMerger struct {
+	*mitumbase.BaseStateValueMerger
+	supply int64
+	sync.RWMutex
+}
+
+func NewSupplyStateValueMerger(height mitumbase.Height, key string, st mitumbase.State) *SupplyStateValueMerger {
+	var supply int64
+
+	if st != nil {
+		if ss, ok := st.Value().(SupplyStateValue); ok {
+			supply = int64(ss.Supply)
+		}
+	}
+
+	return &SupplyStateValueMerger{
+		BaseStateValueMerger: mitumbase.NewBaseStateValueMerger(height, key, st),
+		supply:               supply,
+	}
+}
+
+func (s *SupplyStateValueMerger) Merge(value mitumbase.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	sd, ok := value.(SupplyDeltaStateValue)
+	if !ok {
+		return errors.Errorf("expected SupplyDeltaStateValue, not %T", value)
+	}
+	s.supply += sd.Delta
+
+	s.BaseStateValueMerger.AddOperation(op)
+
+	return nil
+}
+
+func (s *SupplyStateValueMerger) CloseValue() (mitumbase.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.supply < 0 {
+		return nil, errors.Errorf("negative supply, %d", s.supply)
+	}
+
+	s.BaseStateValueMerger.SetValue(NewSupplyStateValue(uint64(s.supply)))
+
+	return s.BaseStateValueMerger.CloseValue()
+}
@@ -3,6 +3,7 @@ package state
 import (
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
 	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -151,3 +152,465 @@ func (s *OperatorsBookStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) err
 
 	return nil
 }
+
+func (s NFTLockStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"lock":  s.Lock,
+		},
+	)
+}
+
+type NFTLockStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Lock bson.Raw `bson:"lock"`
+}
+
+func (s *NFTLockStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of NFTLockStateValue")
+
+	var u NFTLockStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var lock types.NFTLock
+	if err := lock.DecodeBSON(u.Lock, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Lock = lock
+
+	return nil
+}
+
+func (s RestrictionModeStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"mode":  s.Mode,
+		},
+	)
+}
+
+type RestrictionModeStateValueBSONUnmarshaler struct {
+	Hint string `bson:"_hint"`
+	Mode string `bson:"mode"`
+}
+
+func (s *RestrictionModeStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RestrictionModeStateValue")
+
+	var u RestrictionModeStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Mode = types.RestrictionMode(u.Mode)
+
+	return nil
+}
+
+func (s RestrictionStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"listed": s.Listed,
+		},
+	)
+}
+
+type RestrictionStateValueBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	Listed bool   `bson:"listed"`
+}
+
+func (s *RestrictionStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RestrictionStateValue")
+
+	var u RestrictionStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Listed = u.Listed
+
+	return nil
+}
+
+func (s SponsorStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"allowed": s.Allowed,
+		},
+	)
+}
+
+type SponsorStateValueBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Allowed bool   `bson:"allowed"`
+}
+
+func (s *SponsorStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SponsorStateValue")
+
+	var u SponsorStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Allowed = u.Allowed
+
+	return nil
+}
+
+func (s RolesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"roles": s.Roles,
+		},
+	)
+}
+
+type RolesStateValueBSONUnmarshaler struct {
+	Hint  string   `bson:"_hint"`
+	Roles []string `bson:"roles"`
+}
+
+func (s *RolesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RolesStateValue")
+
+	var u RolesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	roles := make([]types.Role, len(u.Roles))
+	for i, r := range u.Roles {
+		roles[i] = types.Role(r)
+	}
+	s.Roles = roles
+
+	return nil
+}
+
+func (s CouncilStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"council": s.Council,
+		},
+	)
+}
+
+type CouncilStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Council bson.Raw `bson:"council"`
+}
+
+func (s *CouncilStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CouncilStateValue")
+
+	var u CouncilStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var council types.Council
+	if err := council.DecodeBSON(u.Council, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Council = council
+
+	return nil
+}
+
+func (s ProposalStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"proposal": s.Proposal,
+		},
+	)
+}
+
+type ProposalStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Proposal bson.Raw `bson:"proposal"`
+}
+
+func (s *ProposalStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ProposalStateValue")
+
+	var u ProposalStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var proposal types.Proposal
+	if err := proposal.DecodeBSON(u.Proposal, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Proposal = proposal
+
+	return nil
+}
+
+func (s NFTHashStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"hash":    s.Hash,
+			"nft_idx": s.Index,
+		},
+	)
+}
+
+type NFTHashStateValueBSONUnmarshaler struct {
+	Hint  string `bson:"_hint"`
+	Hash  string `bson:"hash"`
+	Index uint64 `bson:"nft_idx"`
+}
+
+func (s *NFTHashStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of NFTHashStateValue")
+
+	var u NFTHashStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Hash = types.NFTHash(u.Hash)
+	s.Index = u.Index
+
+	return nil
+}
+
+func (s CollectionNameStateValue) MarshalBSON() ([]byte, error) {
+	var contract string
+	if s.Contract != nil {
+		contract = s.Contract.String()
+	}
+
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"name":     s.Name,
+			"contract": contract,
+		},
+	)
+}
+
+type CollectionNameStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Name     string `bson:"name"`
+	Contract string `bson:"contract"`
+}
+
+func (s *CollectionNameStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CollectionNameStateValue")
+
+	var u CollectionNameStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Name = types.CollectionName(u.Name)
+
+	contract, err := mitumbase.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Contract = contract
+
+	return nil
+}
+
+func (s RelayerSetStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"relayers": s.Relayers,
+		},
+	)
+}
+
+type RelayerSetStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Relayers bson.Raw `bson:"relayers"`
+}
+
+func (s *RelayerSetStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RelayerSetStateValue")
+
+	var u RelayerSetStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var relayers types.RelayerSet
+	if err := relayers.DecodeBSON(u.Relayers, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Relayers = relayers
+
+	return nil
+}
+
+func (s BridgeLockStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"lock":  s.Lock,
+		},
+	)
+}
+
+type BridgeLockStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Lock bson.Raw `bson:"lock"`
+}
+
+func (s *BridgeLockStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of BridgeLockStateValue")
+
+	var u BridgeLockStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var lock types.BridgeLock
+	if err := lock.DecodeBSON(u.Lock, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Lock = lock
+
+	return nil
+}
+
+func (s RegistrationStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"height": s.Height,
+		},
+	)
+}
+
+type RegistrationStateValueBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	Height int64  `bson:"height"`
+}
+
+func (s *RegistrationStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RegistrationStateValue")
+
+	var u RegistrationStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Height = mitumbase.Height(u.Height)
+
+	return nil
+}
+
+func (s SupplyStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"supply": s.Supply,
+		},
+	)
+}
+
+type SupplyStateValueBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	Supply uint64 `bson:"supply"`
+}
+
+func (s *SupplyStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SupplyStateValue")
+
+	var u SupplyStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Supply = u.Supply
+
+	return nil
+}
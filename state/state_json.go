@@ -3,6 +3,7 @@ package state
 import (
 	"encoding/json"
 	"github.com/ProtoconNet/mitum-nft/types"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -146,3 +147,434 @@ func (s *OperatorsBookStateValue) DecodeJSON(b []byte, enc encoder.Encoder) erro
 
 	return nil
 }
+
+type NFTLockStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Lock types.NFTLock `json:"lock"`
+}
+
+func (s NFTLockStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		NFTLockStateValueJSONMarshaler(s),
+	)
+}
+
+type NFTLockStateValueJSONUnmarshaler struct {
+	Hint hint.Hint       `json:"_hint"`
+	Lock json.RawMessage `json:"lock"`
+}
+
+func (s *NFTLockStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of NFTLockStateValue")
+
+	var u NFTLockStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var lock types.NFTLock
+	if err := lock.DecodeJSON(u.Lock, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Lock = lock
+
+	return nil
+}
+
+type RestrictionModeStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Mode types.RestrictionMode `json:"mode"`
+}
+
+func (s RestrictionModeStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		RestrictionModeStateValueJSONMarshaler(s),
+	)
+}
+
+type RestrictionModeStateValueJSONUnmarshaler struct {
+	Hint hint.Hint `json:"_hint"`
+	Mode string    `json:"mode"`
+}
+
+func (s *RestrictionModeStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RestrictionModeStateValue")
+
+	var u RestrictionModeStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Mode = types.RestrictionMode(u.Mode)
+
+	return nil
+}
+
+type RestrictionStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Listed bool `json:"listed"`
+}
+
+func (s RestrictionStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		RestrictionStateValueJSONMarshaler(s),
+	)
+}
+
+type RestrictionStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Listed bool      `json:"listed"`
+}
+
+func (s *RestrictionStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RestrictionStateValue")
+
+	var u RestrictionStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Listed = u.Listed
+
+	return nil
+}
+
+type SponsorStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Allowed bool `json:"allowed"`
+}
+
+func (s SponsorStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		SponsorStateValueJSONMarshaler(s),
+	)
+}
+
+type SponsorStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Allowed bool      `json:"allowed"`
+}
+
+func (s *SponsorStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SponsorStateValue")
+
+	var u SponsorStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Allowed = u.Allowed
+
+	return nil
+}
+
+type RolesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Roles []types.Role `json:"roles"`
+}
+
+func (s RolesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		RolesStateValueJSONMarshaler(s),
+	)
+}
+
+type RolesStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint `json:"_hint"`
+	Roles []string  `json:"roles"`
+}
+
+func (s *RolesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RolesStateValue")
+
+	var u RolesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	roles := make([]types.Role, len(u.Roles))
+	for i, r := range u.Roles {
+		roles[i] = types.Role(r)
+	}
+	s.Roles = roles
+
+	return nil
+}
+
+type CouncilStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Council types.Council `json:"council"`
+}
+
+func (s CouncilStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		CouncilStateValueJSONMarshaler(s),
+	)
+}
+
+type CouncilStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Council json.RawMessage `json:"council"`
+}
+
+func (s *CouncilStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of CouncilStateValue")
+
+	var u CouncilStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var council types.Council
+	if err := council.DecodeJSON(u.Council, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Council = council
+
+	return nil
+}
+
+type ProposalStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Proposal types.Proposal `json:"proposal"`
+}
+
+func (s ProposalStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		ProposalStateValueJSONMarshaler(s),
+	)
+}
+
+type ProposalStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Proposal json.RawMessage `json:"proposal"`
+}
+
+func (s *ProposalStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ProposalStateValue")
+
+	var u ProposalStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var proposal types.Proposal
+	if err := proposal.DecodeJSON(u.Proposal, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Proposal = proposal
+
+	return nil
+}
+
+type NFTHashStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Hash  types.NFTHash `json:"hash"`
+	Index uint64        `json:"nft_idx"`
+}
+
+func (s NFTHashStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		NFTHashStateValueJSONMarshaler(s),
+	)
+}
+
+type NFTHashStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint `json:"_hint"`
+	Hash  string    `json:"hash"`
+	Index uint64    `json:"nft_idx"`
+}
+
+func (s *NFTHashStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of NFTHashStateValue")
+
+	var u NFTHashStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Hash = types.NFTHash(u.Hash)
+	s.Index = u.Index
+
+	return nil
+}
+
+type CollectionNameStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Name     types.CollectionName `json:"name"`
+	Contract mitumbase.Address    `json:"contract"`
+}
+
+func (s CollectionNameStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		CollectionNameStateValueJSONMarshaler(s),
+	)
+}
+
+type CollectionNameStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Name     string    `json:"name"`
+	Contract string    `json:"contract"`
+}
+
+func (s *CollectionNameStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of CollectionNameStateValue")
+
+	var u CollectionNameStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Name = types.CollectionName(u.Name)
+
+	contract, err := mitumbase.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Contract = contract
+
+	return nil
+}
+
+type RelayerSetStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Relayers types.RelayerSet `json:"relayers"`
+}
+
+func (s RelayerSetStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		RelayerSetStateValueJSONMarshaler(s),
+	)
+}
+
+type RelayerSetStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Relayers json.RawMessage `json:"relayers"`
+}
+
+func (s *RelayerSetStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RelayerSetStateValue")
+
+	var u RelayerSetStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var relayers types.RelayerSet
+	if err := relayers.DecodeJSON(u.Relayers, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Relayers = relayers
+
+	return nil
+}
+
+type BridgeLockStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Lock types.BridgeLock `json:"lock"`
+}
+
+func (s BridgeLockStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		BridgeLockStateValueJSONMarshaler(s),
+	)
+}
+
+type BridgeLockStateValueJSONUnmarshaler struct {
+	Hint hint.Hint       `json:"_hint"`
+	Lock json.RawMessage `json:"lock"`
+}
+
+func (s *BridgeLockStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of BridgeLockStateValue")
+
+	var u BridgeLockStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var lock types.BridgeLock
+	if err := lock.DecodeJSON(u.Lock, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Lock = lock
+
+	return nil
+}
+
+type RegistrationStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Height mitumbase.Height `json:"height"`
+}
+
+func (s RegistrationStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		RegistrationStateValueJSONMarshaler(s),
+	)
+}
+
+type RegistrationStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Height int64     `json:"height"`
+}
+
+func (s *RegistrationStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RegistrationStateValue")
+
+	var u RegistrationStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Height = mitumbase.Height(u.Height)
+
+	return nil
+}
+
+type SupplyStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Supply uint64 `json:"supply"`
+}
+
+func (s SupplyStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		SupplyStateValueJSONMarshaler(s),
+	)
+}
+
+type SupplyStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Supply uint64    `json:"supply"`
+}
+
+func (s *SupplyStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SupplyStateValue")
+
+	var u SupplyStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Supply = u.Supply
+
+	return nil
+}
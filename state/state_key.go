@@ -5,7 +5,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ProtoconNet/mitum-nft/types"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
 	"github.com/pkg/errors"
 )
 
@@ -17,14 +19,40 @@ const (
 	OperatorsKey
 	LastIDXKey
 	NFTKey
+	LockKey
+	RestrictionModeKey
+	RestrictionKey
+	RolesKey
+	CouncilKey
+	ProposalKey
+	NFTHashKey
+	CollectionNameKey
+	RelayersKey
+	BridgeKey
+	SponsorKey
+	RegistrationKey
+	SupplyKey
 )
 
 var (
-	NFTPrefix                = "nft"
-	StateKeyCollectionSuffix = "collection"
-	StateKeyOperatorsSuffix  = "operators"
-	StateKeyLastNFTIDXSuffix = "lastnftidx"
-	StateKeyNFTSuffix        = "nft"
+	NFTPrefix                     = "nft"
+	StateKeyCollectionSuffix      = "collection"
+	StateKeyOperatorsSuffix       = "operators"
+	StateKeyLastNFTIDXSuffix      = "lastnftidx"
+	StateKeyNFTSuffix             = "nft"
+	StateKeyNFTLockSuffix         = "lock"
+	StateKeyRestrictionModeSuffix = "restrictionmode"
+	StateKeyRestrictionSuffix     = "restriction"
+	StateKeyRolesSuffix           = "roles"
+	StateKeyCouncilSuffix         = "council"
+	StateKeyProposalSuffix        = "proposal"
+	StateKeyNFTHashSuffix         = "nfthash"
+	StateKeyCollectionNameSuffix  = "collectionname"
+	StateKeyRelayersSuffix        = "relayers"
+	StateKeyBridgeSuffix          = "bridge"
+	StateKeySponsorSuffix         = "sponsor"
+	StateKeyRegistrationSuffix    = "registration"
+	StateKeySupplySuffix          = "supply"
 )
 
 func StateKeyNFTPrefix(addr mitumbase.Address) string {
@@ -42,6 +70,16 @@ func NFTStateKey(
 		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyCollectionSuffix)
 	case LastIDXKey:
 		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyLastNFTIDXSuffix)
+	case RestrictionModeKey:
+		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyRestrictionModeSuffix)
+	case CouncilKey:
+		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyCouncilSuffix)
+	case RelayersKey:
+		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyRelayersSuffix)
+	case RegistrationKey:
+		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyRegistrationSuffix)
+	case SupplyKey:
+		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeySupplySuffix)
 	}
 
 	return stateKey
@@ -55,6 +93,42 @@ func StateKeyNFT(contract mitumbase.Address, id uint64) string {
 	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), strconv.FormatUint(id, 10), StateKeyNFTSuffix)
 }
 
+func StateKeyNFTLock(contract mitumbase.Address, id uint64) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), strconv.FormatUint(id, 10), StateKeyNFTLockSuffix)
+}
+
+func StateKeyNFTBridge(contract mitumbase.Address, id uint64) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), strconv.FormatUint(id, 10), StateKeyBridgeSuffix)
+}
+
+func StateKeyRestriction(contract mitumbase.Address, addr mitumbase.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), addr.String(), StateKeyRestrictionSuffix)
+}
+
+func StateKeySponsor(contract mitumbase.Address, addr mitumbase.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), addr.String(), StateKeySponsorSuffix)
+}
+
+func StateKeyRoles(contract mitumbase.Address, addr mitumbase.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), addr.String(), StateKeyRolesSuffix)
+}
+
+func StateKeyProposal(contract mitumbase.Address, id string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), id, StateKeyProposalSuffix)
+}
+
+// StateKeyNFTHash uses the sha256 digest of hash, because nft hash can have
+// any character including the separator of state key.
+func StateKeyNFTHash(contract mitumbase.Address, hash types.NFTHash) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), valuehash.NewSHA256(hash.Bytes()).String(), StateKeyNFTHashSuffix)
+}
+
+// StateKeyCollectionName is shared by all contract accounts, so a collection name
+// can be claimed by only one collection regardless of letter case.
+func StateKeyCollectionName(name types.CollectionName) string {
+	return fmt.Sprintf("%s:%s:%s", NFTPrefix, valuehash.NewSHA256([]byte(name.Normalize())).String(), StateKeyCollectionNameSuffix)
+}
+
 func ParseNFTStateKey(key string) (StateKey, error) {
 	if !strings.HasPrefix(key, NFTPrefix) {
 		return NilKey, errors.Errorf("invalid NFT State Key")
@@ -68,7 +142,58 @@ func ParseNFTStateKey(key string) (StateKey, error) {
 		return LastIDXKey, nil
 	case strings.HasSuffix(key, StateKeyOperatorsSuffix):
 		return OperatorsKey, nil
+	case strings.HasSuffix(key, StateKeyNFTLockSuffix):
+		return LockKey, nil
+	case strings.HasSuffix(key, StateKeyRestrictionModeSuffix):
+		return RestrictionModeKey, nil
+	case strings.HasSuffix(key, StateKeyRestrictionSuffix):
+		return RestrictionKey, nil
+	case strings.HasSuffix(key, StateKeyRolesSuffix):
+		return RolesKey, nil
+	case strings.HasSuffix(key, StateKeyCouncilSuffix):
+		return CouncilKey, nil
+	case strings.HasSuffix(key, StateKeyProposalSuffix):
+		return ProposalKey, nil
+	case strings.HasSuffix(key, StateKeyNFTHashSuffix):
+		return NFTHashKey, nil
+	case strings.HasSuffix(key, StateKeyCollectionNameSuffix):
+		return CollectionNameKey, nil
+	case strings.HasSuffix(key, StateKeyRelayersSuffix):
+		return RelayersKey, nil
+	case strings.HasSuffix(key, StateKeyBridgeSuffix):
+		return BridgeKey, nil
+	case strings.HasSuffix(key, StateKeySponsorSuffix):
+		return SponsorKey, nil
+	case strings.HasSuffix(key, StateKeyRegistrationSuffix):
+		return RegistrationKey, nil
+	case strings.HasSuffix(key, StateKeySupplySuffix):
+		return SupplyKey, nil
 	default:
 		return NilKey, errors.Errorf("invalid NFT State Key")
 	}
 }
+
+// RegistrationStateKeyOf returns the registration state key of the collection
+// which key belongs to, if key is one of the states kept per registration of
+// collection. These states are left behind when the collection is unregistered,
+// so they are valid only when written after the latest registration.
+func RegistrationStateKeyOf(key string) (string, bool) {
+	k, err := ParseNFTStateKey(key)
+	if err != nil {
+		return "", false
+	}
+
+	switch k {
+	case OperatorsKey, RestrictionModeKey, RestrictionKey, RolesKey, CouncilKey,
+		ProposalKey, NFTHashKey, RelayersKey, SponsorKey:
+	default:
+		return "", false
+	}
+
+	parts := strings.SplitN(key, ":", 3)
+	if len(parts) < 3 {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s:%s:%s", NFTPrefix, parts[1], StateKeyRegistrationSuffix), true
+}
@@ -0,0 +1,103 @@
+package state
+
+import (
+	"testing"
+
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func TestSupplyStateValueMerger(t *testing.T) {
+	key := NFTStateKey(mitumbase.NewStringAddress("contract"), SupplyKey)
+	st := mitumbase.NewBaseState(mitumbase.Height(3), key, NewSupplyStateValue(2), nil, []util.Hash{valuehash.RandomSHA256()})
+
+	merger := NewSupplyStateValueMerger(mitumbase.Height(4), key, st)
+
+	// NOTE the deltas of the operations in one block are all kept.
+	for _, delta := range []int64{1, 1, -1, 2} {
+		if err := merger.Merge(NewSupplyDeltaStateValue(delta), valuehash.RandomSHA256()); err != nil {
+			t.Fatalf("failed to merge: %v", err)
+		}
+	}
+
+	nst, err := merger.CloseValue()
+	if err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+
+	supply, err := StateSupplyValue(nst)
+	if err != nil {
+		t.Fatalf("failed to load supply: %v", err)
+	}
+
+	if supply != 5 {
+		t.Errorf("expected supply 5, not %d", supply)
+	}
+
+	if nst.Height() != mitumbase.Height(4) {
+		t.Errorf("expected height 4, not %v", nst.Height())
+	}
+
+	if len(nst.Operations()) != 4 {
+		t.Errorf("expected 4 operations, not %d", len(nst.Operations()))
+	}
+}
+
+func TestSupplyStateValueMergerNegative(t *testing.T) {
+	key := NFTStateKey(mitumbase.NewStringAddress("contract"), SupplyKey)
+	st := mitumbase.NewBaseState(mitumbase.Height(3), key, NewSupplyStateValue(1), nil, []util.Hash{valuehash.RandomSHA256()})
+
+	merger := NewSupplyStateValueMerger(mitumbase.Height(4), key, st)
+
+	for _, delta := range []int64{-1, -1} {
+		if err := merger.Merge(NewSupplyDeltaStateValue(delta), valuehash.RandomSHA256()); err != nil {
+			t.Fatalf("failed to merge: %v", err)
+		}
+	}
+
+	if _, err := merger.CloseValue(); err == nil {
+		t.Error("expected negative supply error")
+	}
+}
+
+func TestSupplyStateValueMergerWrongValue(t *testing.T) {
+	key := NFTStateKey(mitumbase.NewStringAddress("contract"), SupplyKey)
+
+	merger := NewSupplyStateValueMerger(mitumbase.Height(4), key, nil)
+
+	if err := merger.Merge(NewSupplyStateValue(3), valuehash.RandomSHA256()); err == nil {
+		t.Error("expected error for supply value which is not delta")
+	}
+}
+
+func TestRegistrationStateKeyOf(t *testing.T) {
+	contract := mitumbase.NewStringAddress("contract")
+	account := mitumbase.NewStringAddress("account")
+	registration := NFTStateKey(contract, RegistrationKey)
+
+	for _, key := range []string{
+		NFTStateKey(contract, CouncilKey),
+		NFTStateKey(contract, RelayersKey),
+		StateKeyRoles(contract, account),
+	} {
+		switch rkey, ok := RegistrationStateKeyOf(key); {
+		case !ok:
+			t.Errorf("%q: expected to be kept per registration", key)
+		case rkey != registration:
+			t.Errorf("%q: expected %q, not %q", key, registration, rkey)
+		}
+	}
+
+	// NOTE the collection, nfts and the supply are not reset by registration.
+	for _, key := range []string{
+		NFTStateKey(contract, CollectionKey),
+		NFTStateKey(contract, LastIDXKey),
+		NFTStateKey(contract, SupplyKey),
+		StateKeyNFT(contract, 3),
+	} {
+		if _, ok := RegistrationStateKeyOf(key); ok {
+			t.Errorf("%q: expected not to be kept per registration", key)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	AttributeTypeString  = AttributeType("string")
+	AttributeTypeInteger = AttributeType("integer")
+	AttributeTypeBoolean = AttributeType("boolean")
+)
+
+type AttributeType string
+
+func (t AttributeType) IsValid([]byte) error {
+	switch t {
+	case AttributeTypeString, AttributeTypeInteger, AttributeTypeBoolean:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("wrong attribute type, %v", t)
+	}
+}
+
+func (t AttributeType) Bytes() []byte {
+	return []byte(t)
+}
+
+func (t AttributeType) String() string {
+	return string(t)
+}
+
+var AttributeHint = hint.MustNewHint("mitum-nft-attribute-v0.0.1")
+
+var (
+	MaxAttributes           = 20
+	MaxAttributeKeyLength   = 64
+	MaxAttributeValueLength = 256
+)
+
+// Attribute is a typed key/value trait of nft. The value is kept in its
+// canonical string form, so integer and boolean values compare exactly.
+type Attribute struct {
+	hint.BaseHinter
+	key       string
+	valueType AttributeType
+	value     string
+}
+
+func NewAttribute(key string, valueType AttributeType, value string) Attribute {
+	return Attribute{
+		BaseHinter: hint.NewBaseHinter(AttributeHint),
+		key:        key,
+		valueType:  valueType,
+		value:      value,
+	}
+}
+
+func (a Attribute) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		a.BaseHinter,
+		a.valueType,
+	); err != nil {
+		return err
+	}
+
+	if l := len(a.key); l < 1 {
+		return util.ErrInvalid.Errorf("empty attribute key")
+	} else if l > MaxAttributeKeyLength {
+		return util.ErrInvalid.Errorf("attribute key length over max, %d > %d", l, MaxAttributeKeyLength)
+	}
+
+	if l := len(a.value); l > MaxAttributeValueLength {
+		return util.ErrInvalid.Errorf("attribute value length over max, %d > %d", l, MaxAttributeValueLength)
+	}
+
+	switch a.valueType {
+	case AttributeTypeInteger:
+		i, err := strconv.ParseInt(a.value, 10, 64)
+		if err != nil || strconv.FormatInt(i, 10) != a.value {
+			return util.ErrInvalid.Errorf("wrong integer attribute value, %q", a.value)
+		}
+	case AttributeTypeBoolean:
+		if a.value != "true" && a.value != "false" {
+			return util.ErrInvalid.Errorf("wrong boolean attribute value, %q", a.value)
+		}
+	}
+
+	return nil
+}
+
+func (a Attribute) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(a.key),
+		a.valueType.Bytes(),
+		[]byte(a.value),
+	)
+}
+
+func (a Attribute) Key() string {
+	return a.key
+}
+
+func (a Attribute) Type() AttributeType {
+	return a.valueType
+}
+
+func (a Attribute) Value() string {
+	return a.value
+}
+
+func (a Attribute) Equal(ca Attribute) bool {
+	return a.key == ca.key && a.valueType == ca.valueType && a.value == ca.value
+}
+
+// IsValidAttributes checks the count of attributes and the duplication of keys.
+func IsValidAttributes(attributes []Attribute) error {
+	if l := len(attributes); l > MaxAttributes {
+		return util.ErrInvalid.Errorf("attributes over max, %d > %d", l, MaxAttributes)
+	}
+
+	founds := map[string]struct{}{}
+	for _, a := range attributes {
+		if err := a.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[a.key]; found {
+			return util.ErrInvalid.Errorf("duplicate attribute key, %v", a.key)
+		}
+		founds[a.key] = struct{}{}
+	}
+
+	return nil
+}
+
+func AttributesBytes(attributes []Attribute) []byte {
+	bs := make([][]byte, len(attributes))
+	for i, a := range attributes {
+		bs[i] = a.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
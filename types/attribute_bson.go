@@ -0,0 +1,40 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (a Attribute) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint": a.Hint().String(),
+		"key":   a.key,
+		"type":  a.valueType,
+		"value": a.value,
+	})
+}
+
+type AttributeBSONUnmarshaler struct {
+	Hint  string `bson:"_hint"`
+	Key   string `bson:"key"`
+	Type  string `bson:"type"`
+	Value string `bson:"value"`
+}
+
+func (a *Attribute) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Attribute")
+
+	var u AttributeBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, ht, u.Key, u.Type, u.Value)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (a *Attribute) unpack(
+	_ encoder.Encoder,
+	ht hint.Hint,
+	key, t, value string,
+) error {
+	a.BaseHinter = hint.NewBaseHinter(ht)
+	a.key = key
+	a.valueType = AttributeType(t)
+	a.value = value
+
+	return nil
+}
+
+// DecodeAttributes decodes the hinted list of attributes; missing list is
+// decoded as empty.
+func DecodeAttributes(enc encoder.Encoder, b []byte) ([]Attribute, error) {
+	if len(b) < 1 {
+		return nil, nil
+	}
+
+	hinters, err := enc.DecodeSlice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]Attribute, len(hinters))
+	for i, hinter := range hinters {
+		a, ok := hinter.(Attribute)
+		if !ok {
+			return nil, errors.Errorf("expected Attribute, not %T", hinter)
+		}
+
+		attributes[i] = a
+	}
+
+	return attributes, nil
+}
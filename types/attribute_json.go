@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type AttributeJSONMarshaler struct {
+	hint.BaseHinter
+	Key   string        `json:"key"`
+	Type  AttributeType `json:"type"`
+	Value string        `json:"value"`
+}
+
+func (a Attribute) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AttributeJSONMarshaler{
+		BaseHinter: a.BaseHinter,
+		Key:        a.key,
+		Type:       a.valueType,
+		Value:      a.value,
+	})
+}
+
+type AttributeJSONUnmarshaler struct {
+	Hint  hint.Hint `json:"_hint"`
+	Key   string    `json:"key"`
+	Type  string    `json:"type"`
+	Value string    `json:"value"`
+}
+
+func (a *Attribute) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Attribute")
+
+	var u AttributeJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, u.Hint, u.Key, u.Type, u.Value)
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"regexp"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	MaxBridgeChainLength     = 64
+	MaxBridgeRecipientLength = 128
+	ReValidBridgeChain       = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_:.\-]*$`)
+	ReValidBridgeRecipient   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_:.\-]*$`)
+)
+
+var BridgeReleaseMessagePrefix = []byte("mitum-nft-bridge-release")
+
+var BridgeLockHint = hint.MustNewHint("mitum-nft-bridge-lock-v0.0.1")
+
+// BridgeLock records an nft held in the bridge vault, the contract account,
+// while it is bridged to recipient of the remote chain. Nonce increases by
+// every lock of the nft, so the relayer signs for a former lock can not
+// release it again.
+type BridgeLock struct {
+	hint.BaseHinter
+	owner     base.Address
+	chain     string
+	recipient string
+	nonce     uint64
+	active    bool
+}
+
+func NewBridgeLock(owner base.Address, chain, recipient string, nonce uint64, active bool) BridgeLock {
+	return BridgeLock{
+		BaseHinter: hint.NewBaseHinter(BridgeLockHint),
+		owner:      owner,
+		chain:      chain,
+		recipient:  recipient,
+		nonce:      nonce,
+		active:     active,
+	}
+}
+
+func (l BridgeLock) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		l.BaseHinter,
+		l.owner,
+	); err != nil {
+		return err
+	}
+
+	return IsValidBridgeDestination(l.chain, l.recipient)
+}
+
+func (l BridgeLock) Bytes() []byte {
+	ba := make([]byte, 1)
+
+	if l.active {
+		ba[0] = 1
+	} else {
+		ba[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		l.owner.Bytes(),
+		[]byte(l.chain),
+		[]byte(l.recipient),
+		util.Uint64ToBytes(l.nonce),
+		ba,
+	)
+}
+
+func (l BridgeLock) Owner() base.Address {
+	return l.owner
+}
+
+func (l BridgeLock) Chain() string {
+	return l.chain
+}
+
+func (l BridgeLock) Recipient() string {
+	return l.recipient
+}
+
+func (l BridgeLock) Nonce() uint64 {
+	return l.nonce
+}
+
+func (l BridgeLock) Active() bool {
+	return l.active
+}
+
+// ReleaseMessage returns the message which relayers sign to release nft of idx
+// in contract to receiver.
+func (l BridgeLock) ReleaseMessage(
+	networkID base.NetworkID, contract base.Address, idx uint64, receiver base.Address,
+) []byte {
+	return BridgeReleaseMessage(networkID, contract, idx, l.nonce, receiver)
+}
+
+// BridgeReleaseMessage includes networkID, so the relayer signs for a release
+// in one network can not be replayed in another network.
+func BridgeReleaseMessage(
+	networkID base.NetworkID, contract base.Address, idx, nonce uint64, receiver base.Address,
+) []byte {
+	return util.ConcatBytesSlice(
+		BridgeReleaseMessagePrefix,
+		networkID,
+		contract.Bytes(),
+		util.Uint64ToBytes(idx),
+		util.Uint64ToBytes(nonce),
+		receiver.Bytes(),
+	)
+}
+
+func IsValidBridgeDestination(chain, recipient string) error {
+	switch l := len(chain); {
+	case l < 1:
+		return util.ErrInvalid.Errorf("empty bridge chain")
+	case l > MaxBridgeChainLength:
+		return util.ErrInvalid.Errorf("bridge chain length over max, %d > %d", l, MaxBridgeChainLength)
+	case !ReValidBridgeChain.MatchString(chain):
+		return util.ErrInvalid.Errorf("wrong bridge chain, %q", chain)
+	}
+
+	switch l := len(recipient); {
+	case l < 1:
+		return util.ErrInvalid.Errorf("empty bridge recipient")
+	case l > MaxBridgeRecipientLength:
+		return util.ErrInvalid.Errorf("bridge recipient length over max, %d > %d", l, MaxBridgeRecipientLength)
+	case !ReValidBridgeRecipient.MatchString(recipient):
+		return util.ErrInvalid.Errorf("wrong bridge recipient, %q", recipient)
+	}
+
+	return nil
+}
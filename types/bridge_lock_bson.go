@@ -0,0 +1,45 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l BridgeLock) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":     l.Hint().String(),
+		"owner":     l.owner,
+		"chain":     l.chain,
+		"recipient": l.recipient,
+		"nonce":     l.nonce,
+		"active":    l.active,
+	})
+}
+
+type BridgeLockBSONUnmarshaler struct {
+	Hint      string `bson:"_hint"`
+	Owner     string `bson:"owner"`
+	Chain     string `bson:"chain"`
+	Recipient string `bson:"recipient"`
+	Nonce     uint64 `bson:"nonce"`
+	Active    bool   `bson:"active"`
+}
+
+func (l *BridgeLock) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of BridgeLock")
+
+	var u BridgeLockBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return l.unpack(enc, ht, u.Owner, u.Chain, u.Recipient, u.Nonce, u.Active)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l *BridgeLock) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ow string,
+	ch string,
+	rc string,
+	nc uint64,
+	ac bool,
+) error {
+	l.BaseHinter = hint.NewBaseHinter(ht)
+	l.chain = ch
+	l.recipient = rc
+	l.nonce = nc
+	l.active = ac
+
+	owner, err := base.DecodeAddress(ow, enc)
+	if err != nil {
+		return err
+	}
+	l.owner = owner
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type BridgeLockJSONMarshaler struct {
+	hint.BaseHinter
+	Owner     base.Address `json:"owner"`
+	Chain     string       `json:"chain"`
+	Recipient string       `json:"recipient"`
+	Nonce     uint64       `json:"nonce"`
+	Active    bool         `json:"active"`
+}
+
+func (l BridgeLock) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BridgeLockJSONMarshaler{
+		BaseHinter: l.BaseHinter,
+		Owner:      l.owner,
+		Chain:      l.chain,
+		Recipient:  l.recipient,
+		Nonce:      l.nonce,
+		Active:     l.active,
+	})
+}
+
+type BridgeLockJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Owner     string    `json:"owner"`
+	Chain     string    `json:"chain"`
+	Recipient string    `json:"recipient"`
+	Nonce     uint64    `json:"nonce"`
+	Active    bool      `json:"active"`
+}
+
+func (l *BridgeLock) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of BridgeLock")
+
+	var u BridgeLockJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return l.unpack(enc, u.Hint, u.Owner, u.Chain, u.Recipient, u.Nonce, u.Active)
+}
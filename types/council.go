@@ -0,0 +1,94 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var MaxCouncilMembers = 20
+
+var CouncilHint = hint.MustNewHint("mitum-nft-council-v0.0.1")
+
+// Council is the set of accounts which govern collection policy and administration changes;
+// a proposal is applied once threshold members approve it.
+type Council struct {
+	hint.BaseHinter
+	members   []mitumbase.Address
+	threshold uint
+}
+
+func NewCouncil(members []mitumbase.Address, threshold uint) Council {
+	return Council{
+		BaseHinter: hint.NewBaseHinter(CouncilHint),
+		members:    members,
+		threshold:  threshold,
+	}
+}
+
+func (c Council) IsValid([]byte) error {
+	if err := c.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	l := len(c.members)
+	if l < 1 {
+		return common.ErrArrayLen.Wrap(errors.Errorf("empty council members"))
+	} else if l > MaxCouncilMembers {
+		return common.ErrArrayLen.Wrap(errors.Errorf("council members over allowed, %d > %d", l, MaxCouncilMembers))
+	}
+
+	if c.threshold < 1 || c.threshold > uint(l) {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("council threshold out of range, 1 <= %d <= %d", c.threshold, l))
+	}
+
+	founds := map[string]struct{}{}
+	for _, m := range c.members {
+		if err := m.IsValid(nil); err != nil {
+			return err
+		}
+		if _, found := founds[m.String()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("duplicate council member found, %v", m))
+		}
+		founds[m.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (c Council) Bytes() []byte {
+	as := make([][]byte, len(c.members))
+	for i, m := range c.members {
+		as[i] = m.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(as...),
+		util.UintToBytes(c.threshold),
+	)
+}
+
+func (c Council) Members() []mitumbase.Address {
+	return c.members
+}
+
+func (c Council) Threshold() uint {
+	return c.threshold
+}
+
+func (c Council) IsMember(a mitumbase.Address) bool {
+	for _, m := range c.members {
+		if m.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c Council) Addresses() ([]mitumbase.Address, error) {
+	return c.members, nil
+}
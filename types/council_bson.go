@@ -0,0 +1,39 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (c Council) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":     c.Hint().String(),
+		"members":   c.members,
+		"threshold": c.threshold,
+	})
+}
+
+type CouncilBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Members   []string `bson:"members"`
+	Threshold uint     `bson:"threshold"`
+}
+
+func (c *Council) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Council")
+
+	var u CouncilBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, ht, u.Members, u.Threshold)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (c *Council) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bms []string,
+	th uint,
+) error {
+	c.BaseHinter = hint.NewBaseHinter(ht)
+	c.threshold = th
+
+	members := make([]base.Address, len(bms))
+	for i, bm := range bms {
+		m, err := base.DecodeAddress(bm, enc)
+		if err != nil {
+			return err
+		}
+		members[i] = m
+	}
+	c.members = members
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CouncilJSONMarshaler struct {
+	hint.BaseHinter
+	Members   []base.Address `json:"members"`
+	Threshold uint           `json:"threshold"`
+}
+
+func (c Council) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CouncilJSONMarshaler{
+		BaseHinter: c.BaseHinter,
+		Members:    c.members,
+		Threshold:  c.threshold,
+	})
+}
+
+type CouncilJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Members   []string  `json:"members"`
+	Threshold uint      `json:"threshold"`
+}
+
+func (c *Council) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Council")
+
+	var u CouncilJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, u.Hint, u.Members, u.Threshold)
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"regexp"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	MaxLengthCollectionSymbol = 10
+	ReValidCollectionSymbol   = regexp.MustCompile(`^[A-Z0-9]+$`)
+	MaxLengthDescription      = 1000
+)
+
+type CollectionSymbol string
+
+func (cs CollectionSymbol) IsValid([]byte) error {
+	if cs == "" {
+		return nil
+	}
+
+	if l := len(cs); l > MaxLengthCollectionSymbol {
+		return util.ErrInvalid.Errorf(
+			"collection symbol length over max, %d > %d", l, MaxLengthCollectionSymbol)
+	}
+
+	if !ReValidCollectionSymbol.Match([]byte(cs)) {
+		return util.ErrInvalid.Errorf("wrong collection symbol, %v", cs)
+	}
+
+	return nil
+}
+
+func (cs CollectionSymbol) Bytes() []byte {
+	return []byte(cs)
+}
+
+func (cs CollectionSymbol) String() string {
+	return string(cs)
+}
+
+var CollectionMetadataHint = hint.MustNewHint("mitum-nft-collection-metadata-v0.0.1")
+
+// CollectionMetadata is the descriptive information of collection for wallets
+// and marketplaces; every field is optional.
+type CollectionMetadata struct {
+	hint.BaseHinter
+	symbol       CollectionSymbol
+	description  string
+	externalLink URI
+	image        URI
+	banner       URI
+}
+
+func NewCollectionMetadata(
+	symbol CollectionSymbol, description string, externalLink, image, banner URI,
+) CollectionMetadata {
+	return CollectionMetadata{
+		BaseHinter:   hint.NewBaseHinter(CollectionMetadataHint),
+		symbol:       symbol,
+		description:  description,
+		externalLink: externalLink,
+		image:        image,
+		banner:       banner,
+	}
+}
+
+func (md CollectionMetadata) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		md.BaseHinter,
+		md.symbol,
+		md.externalLink,
+		md.image,
+		md.banner,
+	); err != nil {
+		return err
+	}
+
+	if l := len(md.description); l > MaxLengthDescription {
+		return util.ErrInvalid.Errorf("description length over max, %d > %d", l, MaxLengthDescription)
+	}
+
+	return nil
+}
+
+func (md CollectionMetadata) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		md.symbol.Bytes(),
+		[]byte(md.description),
+		md.externalLink.Bytes(),
+		md.image.Bytes(),
+		md.banner.Bytes(),
+	)
+}
+
+func (md CollectionMetadata) Symbol() CollectionSymbol {
+	return md.symbol
+}
+
+func (md CollectionMetadata) Description() string {
+	return md.description
+}
+
+func (md CollectionMetadata) ExternalLink() URI {
+	return md.externalLink
+}
+
+func (md CollectionMetadata) Image() URI {
+	return md.image
+}
+
+func (md CollectionMetadata) Banner() URI {
+	return md.banner
+}
+
+func (md CollectionMetadata) Equal(cmd CollectionMetadata) bool {
+	return md.symbol == cmd.symbol &&
+		md.description == cmd.description &&
+		md.externalLink == cmd.externalLink &&
+		md.image == cmd.image &&
+		md.banner == cmd.banner
+}
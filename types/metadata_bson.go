@@ -0,0 +1,45 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (md CollectionMetadata) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":         md.Hint().String(),
+		"symbol":        md.symbol,
+		"description":   md.description,
+		"external_link": md.externalLink,
+		"image":         md.image,
+		"banner":        md.banner,
+	})
+}
+
+type CollectionMetadataBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	Symbol       string `bson:"symbol"`
+	Description  string `bson:"description"`
+	ExternalLink string `bson:"external_link"`
+	Image        string `bson:"image"`
+	Banner       string `bson:"banner"`
+}
+
+func (md *CollectionMetadata) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CollectionMetadata")
+
+	var u CollectionMetadataBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return md.unpack(enc, ht, u.Symbol, u.Description, u.ExternalLink, u.Image, u.Banner)
+}
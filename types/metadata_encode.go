@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (md *CollectionMetadata) unpack(
+	_ encoder.Encoder,
+	ht hint.Hint,
+	sy, ds, el, im, bn string,
+) error {
+	md.BaseHinter = hint.NewBaseHinter(ht)
+	md.symbol = CollectionSymbol(sy)
+	md.description = ds
+	md.externalLink = URI(el)
+	md.image = URI(im)
+	md.banner = URI(bn)
+
+	return nil
+}
+
+// DecodeCollectionMetadata decodes the hinted collection metadata; missing
+// metadata of the older policies is decoded as empty.
+func DecodeCollectionMetadata(enc encoder.Encoder, b []byte) (CollectionMetadata, error) {
+	if len(b) < 1 {
+		return NewCollectionMetadata("", "", "", "", ""), nil
+	}
+
+	hinter, err := enc.Decode(b)
+	switch {
+	case err != nil:
+		return CollectionMetadata{}, err
+	case hinter == nil:
+		return NewCollectionMetadata("", "", "", "", ""), nil
+	}
+
+	md, ok := hinter.(CollectionMetadata)
+	if !ok {
+		return CollectionMetadata{}, errors.Errorf("expected CollectionMetadata, not %T", hinter)
+	}
+
+	return md, nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CollectionMetadataJSONMarshaler struct {
+	hint.BaseHinter
+	Symbol       CollectionSymbol `json:"symbol"`
+	Description  string           `json:"description"`
+	ExternalLink URI              `json:"external_link"`
+	Image        URI              `json:"image"`
+	Banner       URI              `json:"banner"`
+}
+
+func (md CollectionMetadata) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CollectionMetadataJSONMarshaler{
+		BaseHinter:   md.BaseHinter,
+		Symbol:       md.symbol,
+		Description:  md.description,
+		ExternalLink: md.externalLink,
+		Image:        md.image,
+		Banner:       md.banner,
+	})
+}
+
+type CollectionMetadataJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	Symbol       string    `json:"symbol"`
+	Description  string    `json:"description"`
+	ExternalLink string    `json:"external_link"`
+	Image        string    `json:"image"`
+	Banner       string    `json:"banner"`
+}
+
+func (md *CollectionMetadata) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of CollectionMetadata")
+
+	var u CollectionMetadataJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return md.unpack(enc, u.Hint, u.Symbol, u.Description, u.ExternalLink, u.Image, u.Banner)
+}
@@ -33,19 +33,25 @@ func (hs NFTHash) String() string {
 	return string(hs)
 }
 
-var NFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.1")
+var (
+	NFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.2")
+	// LegacyNFTHint is the nft before attributes and provenance; it is decoded into NFT without them.
+	LegacyNFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.1")
+)
 
 var MaxCreators = 10
 
 type NFT struct {
 	hint.BaseHinter
-	id       uint64
-	active   bool
-	owner    base.Address
-	hash     NFTHash
-	uri      URI
-	approved base.Address
-	creators Signers
+	id         uint64
+	active     bool
+	owner      base.Address
+	hash       NFTHash
+	uri        URI
+	approved   base.Address
+	creators   Signers
+	attributes []Attribute
+	provenance Provenance
 }
 
 func NewNFT(
@@ -56,6 +62,8 @@ func NewNFT(
 	uri URI,
 	approved base.Address,
 	creators Signers,
+	attributes []Attribute,
+	provenance Provenance,
 ) NFT {
 	return NFT{
 		BaseHinter: hint.NewBaseHinter(NFTHint),
@@ -66,6 +74,8 @@ func NewNFT(
 		uri:        uri,
 		approved:   approved,
 		creators:   creators,
+		attributes: attributes,
+		provenance: provenance,
 	}
 }
 
@@ -76,6 +86,7 @@ func (n NFT) IsValid([]byte) error {
 		n.uri,
 		n.approved,
 		n.creators,
+		n.provenance,
 	); err != nil {
 		return err
 	}
@@ -84,6 +95,10 @@ func (n NFT) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("empty uri")
 	}
 
+	if err := IsValidAttributes(n.attributes); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -104,6 +119,8 @@ func (n NFT) Bytes() []byte {
 		[]byte(n.uri.String()),
 		n.approved.Bytes(),
 		n.creators.Bytes(),
+		AttributesBytes(n.attributes),
+		n.provenance.Bytes(),
 	)
 }
 
@@ -135,6 +152,26 @@ func (n NFT) Creators() Signers {
 	return n.creators
 }
 
+func (n NFT) Attributes() []Attribute {
+	return n.attributes
+}
+
+// Provenance returns the source of the migrated nft; it is empty for nft
+// minted in its own collection.
+func (n NFT) Provenance() Provenance {
+	return n.provenance
+}
+
+func (n NFT) Attribute(key string) (Attribute, bool) {
+	for _, a := range n.attributes {
+		if a.Key() == key {
+			return a, true
+		}
+	}
+
+	return Attribute{}, false
+}
+
 func (n NFT) Addresses() []base.Address {
 	var as []base.Address
 	copy(as, n.Creators().Addresses())
@@ -190,6 +227,20 @@ func (n NFT) Equal(cn NFT) bool {
 		return false
 	}
 
+	if len(n.attributes) != len(cn.attributes) {
+		return false
+	}
+
+	for i := range n.attributes {
+		if !n.attributes[i].Equal(cn.attributes[i]) {
+			return false
+		}
+	}
+
+	if !n.provenance.Equal(cn.provenance) {
+		return false
+	}
+
 	return n.ID() == cn.ID()
 }
 
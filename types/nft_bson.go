@@ -9,27 +9,36 @@ import (
 )
 
 func (n NFT) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bson.M{
-		"_hint":    n.Hint().String(),
-		"nft_idx":  n.id,
-		"active":   n.active,
-		"owner":    n.owner,
-		"hash":     n.hash,
-		"uri":      n.uri,
-		"approved": n.approved,
-		"creators": n.creators,
-	})
+	m := bson.M{
+		"_hint":      n.Hint().String(),
+		"nft_idx":    n.id,
+		"active":     n.active,
+		"owner":      n.owner,
+		"hash":       n.hash,
+		"uri":        n.uri,
+		"approved":   n.approved,
+		"creators":   n.creators,
+		"attributes": n.attributes,
+	}
+
+	if !n.provenance.IsEmpty() {
+		m["provenance"] = n.provenance
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type NFTBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	ID       uint64   `bson:"nft_idx"`
-	Active   bool     `bson:"active"`
-	Owner    string   `bson:"owner"`
-	Hash     string   `bson:"hash"`
-	URI      string   `bson:"uri"`
-	Approved string   `bson:"approved"`
-	Creators bson.Raw `bson:"creators"`
+	Hint       string   `bson:"_hint"`
+	ID         uint64   `bson:"nft_idx"`
+	Active     bool     `bson:"active"`
+	Owner      string   `bson:"owner"`
+	Hash       string   `bson:"hash"`
+	URI        string   `bson:"uri"`
+	Approved   string   `bson:"approved"`
+	Creators   bson.Raw `bson:"creators"`
+	Attributes bson.Raw `bson:"attributes"`
+	Provenance bson.Raw `bson:"provenance"`
 }
 
 func (n *NFT) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -45,5 +54,5 @@ func (n *NFT) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return n.unpack(enc, ht, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators)
+	return n.unpack(enc, ht, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Attributes, u.Provenance)
 }
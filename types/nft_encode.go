@@ -17,6 +17,8 @@ func (n *NFT) unpack(
 	uri string,
 	ap string,
 	bcrs []byte,
+	bats []byte,
+	bpv []byte,
 ) error {
 	n.BaseHinter = hint.NewBaseHinter(ht)
 	n.active = ac
@@ -44,5 +46,17 @@ func (n *NFT) unpack(
 		n.creators = sns
 	}
 
+	attributes, err := DecodeAttributes(enc, bats)
+	if err != nil {
+		return err
+	}
+	n.attributes = attributes
+
+	provenance, err := DecodeProvenance(enc, bpv)
+	if err != nil {
+		return err
+	}
+	n.provenance = provenance
+
 	return nil
 }
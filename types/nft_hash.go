@@ -0,0 +1,122 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	HashAlgorithmSHA256     = HashAlgorithm("sha256")
+	HashAlgorithmSHA3256    = HashAlgorithm("sha3-256")
+	HashAlgorithmBlake2b256 = HashAlgorithm("blake2b-256")
+	HashAlgorithmCID        = HashAlgorithm("cid")
+)
+
+var (
+	reValidHexDigest = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	reValidCIDv0     = regexp.MustCompile(`^Qm[1-9A-HJ-NP-Za-km-z]{44}$`)
+	reValidCIDv1     = regexp.MustCompile(`^b[a-z2-7]{58,}$`)
+)
+
+// HashAlgorithm identifies how the content digest of typed NFTHash is made.
+type HashAlgorithm string
+
+func (algo HashAlgorithm) IsValid([]byte) error {
+	switch algo {
+	case HashAlgorithmSHA256, HashAlgorithmSHA3256, HashAlgorithmBlake2b256, HashAlgorithmCID:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown hash algorithm, %v", algo)
+	}
+}
+
+func (algo HashAlgorithm) String() string {
+	return string(algo)
+}
+
+// IsValidDigest checks the format of digest; hex digests of fixed size
+// algorithms should be lowercase.
+func (algo HashAlgorithm) IsValidDigest(digest string) error {
+	switch algo {
+	case HashAlgorithmSHA256, HashAlgorithmSHA3256, HashAlgorithmBlake2b256:
+		if !reValidHexDigest.MatchString(digest) {
+			return util.ErrInvalid.Errorf("wrong %v digest, %q", algo, digest)
+		}
+	case HashAlgorithmCID:
+		if !reValidCIDv0.MatchString(digest) && !reValidCIDv1.MatchString(digest) {
+			return util.ErrInvalid.Errorf("wrong cid, %q", digest)
+		}
+	default:
+		return util.ErrInvalid.Errorf("unknown hash algorithm, %v", algo)
+	}
+
+	return nil
+}
+
+// Sum computes the typed NFTHash of content read from r. CID can not be
+// computed locally, because it depends on the chunking of IPFS.
+func (algo HashAlgorithm) Sum(r io.Reader) (NFTHash, error) {
+	var h hash.Hash
+
+	switch algo {
+	case HashAlgorithmSHA256:
+		h = sha256.New()
+	case HashAlgorithmSHA3256:
+		h = sha3.New256()
+	case HashAlgorithmBlake2b256:
+		b, err := blake2b.New256(nil)
+		if err != nil {
+			return "", err
+		}
+		h = b
+	default:
+		return "", errors.Errorf("hash algorithm %v can not be computed locally", algo)
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	return NewTypedNFTHash(algo, hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// NewTypedNFTHash makes NFTHash of "<algorithm>:<digest>".
+func NewTypedNFTHash(algo HashAlgorithm, digest string) NFTHash {
+	return NFTHash(algo.String() + ":" + digest)
+}
+
+// Typed splits NFTHash into algorithm and digest. It returns false for the
+// opaque hashes which have no known algorithm prefix.
+func (hs NFTHash) Typed() (HashAlgorithm, string, bool) {
+	l := strings.SplitN(string(hs), ":", 2)
+	if len(l) != 2 {
+		return "", "", false
+	}
+
+	algo := HashAlgorithm(l[0])
+	if err := algo.IsValid(nil); err != nil {
+		return "", "", false
+	}
+
+	return algo, l[1], true
+}
+
+// IsValidTyped checks the digest of typed NFTHash. The opaque hashes are
+// left as they are for the collections minted before typed hash.
+func (hs NFTHash) IsValidTyped() error {
+	algo, digest, ok := hs.Typed()
+	if !ok {
+		return nil
+	}
+
+	return algo.IsValidDigest(digest)
+}
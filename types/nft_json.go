@@ -10,16 +10,23 @@ import (
 
 type NFTJSONMarshaler struct {
 	hint.BaseHinter
-	ID       uint64       `json:"nft_idx"`
-	Active   bool         `json:"active"`
-	Owner    base.Address `json:"owner"`
-	Hash     NFTHash      `json:"hash"`
-	URI      URI          `json:"uri"`
-	Approved base.Address `json:"approved"`
-	Creators Signers      `json:"creators"`
+	ID         uint64       `json:"nft_idx"`
+	Active     bool         `json:"active"`
+	Owner      base.Address `json:"owner"`
+	Hash       NFTHash      `json:"hash"`
+	URI        URI          `json:"uri"`
+	Approved   base.Address `json:"approved"`
+	Creators   Signers      `json:"creators"`
+	Attributes []Attribute  `json:"attributes"`
+	Provenance *Provenance  `json:"provenance,omitempty"`
 }
 
 func (n NFT) MarshalJSON() ([]byte, error) {
+	var provenance *Provenance
+	if !n.provenance.IsEmpty() {
+		provenance = &n.provenance
+	}
+
 	return util.MarshalJSON(NFTJSONMarshaler{
 		BaseHinter: n.BaseHinter,
 		ID:         n.id,
@@ -29,18 +36,22 @@ func (n NFT) MarshalJSON() ([]byte, error) {
 		URI:        n.uri,
 		Approved:   n.approved,
 		Creators:   n.creators,
+		Attributes: n.attributes,
+		Provenance: provenance,
 	})
 }
 
 type NFTJSONUnmarshaler struct {
-	Hint     hint.Hint       `json:"_hint"`
-	ID       uint64          `json:"nft_idx"`
-	Active   bool            `json:"active"`
-	Owner    string          `json:"owner"`
-	Hash     string          `json:"hash"`
-	URI      string          `json:"uri"`
-	Approved string          `json:"approved"`
-	Creators json.RawMessage `json:"creators"`
+	Hint       hint.Hint       `json:"_hint"`
+	ID         uint64          `json:"nft_idx"`
+	Active     bool            `json:"active"`
+	Owner      string          `json:"owner"`
+	Hash       string          `json:"hash"`
+	URI        string          `json:"uri"`
+	Approved   string          `json:"approved"`
+	Creators   json.RawMessage `json:"creators"`
+	Attributes json.RawMessage `json:"attributes"`
+	Provenance json.RawMessage `json:"provenance"`
 }
 
 func (n *NFT) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -51,5 +62,5 @@ func (n *NFT) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return n.unpack(enc, u.Hint, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators)
+	return n.unpack(enc, u.Hint, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Attributes, u.Provenance)
 }
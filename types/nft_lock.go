@@ -0,0 +1,79 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var NFTLockHint = hint.MustNewHint("mitum-nft-nft-lock-v0.0.1")
+
+// NFTLock records an nft escrowed by the contract account until releaseHeight.
+// The receiver can claim the nft from releaseHeight, and the owner can take it back before then.
+type NFTLock struct {
+	hint.BaseHinter
+	owner         base.Address
+	receiver      base.Address
+	releaseHeight base.Height
+	active        bool
+}
+
+func NewNFTLock(owner, receiver base.Address, releaseHeight base.Height, active bool) NFTLock {
+	return NFTLock{
+		BaseHinter:    hint.NewBaseHinter(NFTLockHint),
+		owner:         owner,
+		receiver:      receiver,
+		releaseHeight: releaseHeight,
+		active:        active,
+	}
+}
+
+func (l NFTLock) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		l.BaseHinter,
+		l.owner,
+		l.receiver,
+		l.releaseHeight,
+	); err != nil {
+		return err
+	}
+
+	if l.owner.Equal(l.receiver) {
+		return util.ErrInvalid.Errorf("receiver %v is same with owner", l.receiver)
+	}
+
+	return nil
+}
+
+func (l NFTLock) Bytes() []byte {
+	ba := make([]byte, 1)
+
+	if l.active {
+		ba[0] = 1
+	} else {
+		ba[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		l.owner.Bytes(),
+		l.receiver.Bytes(),
+		l.releaseHeight.Bytes(),
+		ba,
+	)
+}
+
+func (l NFTLock) Owner() base.Address {
+	return l.owner
+}
+
+func (l NFTLock) Receiver() base.Address {
+	return l.receiver
+}
+
+func (l NFTLock) ReleaseHeight() base.Height {
+	return l.releaseHeight
+}
+
+func (l NFTLock) Active() bool {
+	return l.active
+}
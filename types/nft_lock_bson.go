@@ -0,0 +1,43 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l NFTLock) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":          l.Hint().String(),
+		"owner":          l.owner,
+		"receiver":       l.receiver,
+		"release_height": l.releaseHeight,
+		"active":         l.active,
+	})
+}
+
+type NFTLockBSONUnmarshaler struct {
+	Hint          string `bson:"_hint"`
+	Owner         string `bson:"owner"`
+	Receiver      string `bson:"receiver"`
+	ReleaseHeight int64  `bson:"release_height"`
+	Active        bool   `bson:"active"`
+}
+
+func (l *NFTLock) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of NFTLock")
+
+	var u NFTLockBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return l.unpack(enc, ht, u.Owner, u.Receiver, u.ReleaseHeight, u.Active)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l *NFTLock) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ow, rc string,
+	rh int64,
+	ac bool,
+) error {
+	l.BaseHinter = hint.NewBaseHinter(ht)
+	l.releaseHeight = base.Height(rh)
+	l.active = ac
+
+	owner, err := base.DecodeAddress(ow, enc)
+	if err != nil {
+		return err
+	}
+	l.owner = owner
+
+	receiver, err := base.DecodeAddress(rc, enc)
+	if err != nil {
+		return err
+	}
+	l.receiver = receiver
+
+	return nil
+}
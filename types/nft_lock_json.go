@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type NFTLockJSONMarshaler struct {
+	hint.BaseHinter
+	Owner         base.Address `json:"owner"`
+	Receiver      base.Address `json:"receiver"`
+	ReleaseHeight base.Height  `json:"release_height"`
+	Active        bool         `json:"active"`
+}
+
+func (l NFTLock) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NFTLockJSONMarshaler{
+		BaseHinter:    l.BaseHinter,
+		Owner:         l.owner,
+		Receiver:      l.receiver,
+		ReleaseHeight: l.releaseHeight,
+		Active:        l.active,
+	})
+}
+
+type NFTLockJSONUnmarshaler struct {
+	Hint          hint.Hint `json:"_hint"`
+	Owner         string    `json:"owner"`
+	Receiver      string    `json:"receiver"`
+	ReleaseHeight int64     `json:"release_height"`
+	Active        bool      `json:"active"`
+}
+
+func (l *NFTLock) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of NFTLock")
+
+	var u NFTLockJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return l.unpack(enc, u.Hint, u.Owner, u.Receiver, u.ReleaseHeight, u.Active)
+}
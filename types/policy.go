@@ -5,6 +5,7 @@ import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"regexp"
 	"sort"
+	"strings"
 
 	mitumbase "github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -50,23 +51,44 @@ func (cn CollectionName) String() string {
 	return string(cn)
 }
 
-var CollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.1")
+// Normalize returns the case-insensitive form of name used to detect name collisions.
+func (cn CollectionName) Normalize() string {
+	return strings.ToLower(string(cn))
+}
+
+var (
+	CollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.2")
+	// LegacyCollectionPolicyHint is the policy before clawback, unique hash, metadata and uri policy;
+	// it is decoded into CollectionPolicy with them empty.
+	LegacyCollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.1")
+)
 
 type CollectionPolicy struct {
 	hint.BaseHinter
-	name      CollectionName
-	royalty   PaymentParameter
-	uri       URI
-	whitelist []mitumbase.Address
+	name       CollectionName
+	royalty    PaymentParameter
+	uri        URI
+	whitelist  []mitumbase.Address
+	clawback   bool
+	uniqueHash bool
+	metadata   CollectionMetadata
+	uriPolicy  URIPolicy
 }
 
-func NewCollectionPolicy(name CollectionName, royalty PaymentParameter, uri URI, whitelist []mitumbase.Address) CollectionPolicy {
+func NewCollectionPolicy(
+	name CollectionName, royalty PaymentParameter, uri URI, whitelist []mitumbase.Address, clawback, uniqueHash bool,
+	metadata CollectionMetadata, uriPolicy URIPolicy,
+) CollectionPolicy {
 	return CollectionPolicy{
 		BaseHinter: hint.NewBaseHinter(CollectionPolicyHint),
 		name:       name,
 		royalty:    royalty,
 		uri:        uri,
 		whitelist:  whitelist,
+		clawback:   clawback,
+		uniqueHash: uniqueHash,
+		metadata:   metadata,
+		uriPolicy:  uriPolicy,
 	}
 }
 
@@ -76,10 +98,16 @@ func (policy CollectionPolicy) IsValid([]byte) error {
 		policy.name,
 		policy.royalty,
 		policy.uri,
+		policy.metadata,
+		policy.uriPolicy,
 	); err != nil {
 		return err
 	}
 
+	if err := policy.uriPolicy.PermitsAll(policy.uri, policy.metadata.Image(), policy.metadata.Banner()); err != nil {
+		return err
+	}
+
 	if l := len(policy.whitelist); l > MaxWhitelist {
 		return common.ErrArrayLen.Wrap(errors.Errorf("whitelist over allowed, %d > %d", l, MaxWhitelist))
 	}
@@ -104,11 +132,25 @@ func (policy CollectionPolicy) Bytes() []byte {
 		as[i] = white.Bytes()
 	}
 
+	var cb []byte
+	if policy.clawback {
+		cb = []byte{1}
+	}
+
+	var uh []byte
+	if policy.uniqueHash {
+		uh = []byte{1}
+	}
+
 	return util.ConcatBytesSlice(
 		policy.name.Bytes(),
 		policy.royalty.Bytes(),
 		policy.uri.Bytes(),
 		util.ConcatBytesSlice(as...),
+		cb,
+		uh,
+		policy.metadata.Bytes(),
+		policy.uriPolicy.Bytes(),
 	)
 }
 
@@ -128,6 +170,24 @@ func (policy CollectionPolicy) Whitelist() []mitumbase.Address {
 	return policy.whitelist
 }
 
+// Clawback reports whether the collection creator can force transfer nfts regardless of owner or approvals.
+func (policy CollectionPolicy) Clawback() bool {
+	return policy.clawback
+}
+
+// UniqueHash reports whether nfts of the collection should have distinct nft hashes.
+func (policy CollectionPolicy) UniqueHash() bool {
+	return policy.uniqueHash
+}
+
+func (policy CollectionPolicy) Metadata() CollectionMetadata {
+	return policy.metadata
+}
+
+func (policy CollectionPolicy) URIPolicy() URIPolicy {
+	return policy.uriPolicy
+}
+
 func (policy CollectionPolicy) Addresses() ([]mitumbase.Address, error) {
 	return policy.whitelist, nil
 }
@@ -150,6 +210,22 @@ func (policy CollectionPolicy) Equal(c BasePolicy) bool {
 		return false
 	}
 
+	if policy.clawback != cpolicy.clawback {
+		return false
+	}
+
+	if policy.uniqueHash != cpolicy.uniqueHash {
+		return false
+	}
+
+	if !policy.metadata.Equal(cpolicy.metadata) {
+		return false
+	}
+
+	if !policy.uriPolicy.Equal(cpolicy.uriPolicy) {
+		return false
+	}
+
 	if len(policy.whitelist) != len(cpolicy.whitelist) {
 		return false
 	}
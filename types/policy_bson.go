@@ -15,15 +15,23 @@ func (policy CollectionPolicy) MarshalBSON() ([]byte, error) {
 		"royalty":          policy.royalty,
 		"uri":              policy.uri,
 		"minter_whitelist": policy.whitelist,
+		"clawback":         policy.clawback,
+		"unique_hash":      policy.uniqueHash,
+		"metadata":         policy.metadata,
+		"uri_policy":       policy.uriPolicy,
 	})
 }
 
 type PolicyBSONUnmarshaler struct {
-	Hint    string   `bson:"_hint"`
-	Name    string   `bson:"name"`
-	Royalty uint     `bson:"royalty"`
-	URI     string   `bson:"uri"`
-	Whites  []string `bson:"minter_whitelist"`
+	Hint       string   `bson:"_hint"`
+	Name       string   `bson:"name"`
+	Royalty    uint     `bson:"royalty"`
+	URI        string   `bson:"uri"`
+	Whites     []string `bson:"minter_whitelist"`
+	Clawback   bool     `bson:"clawback"`
+	UniqueHash bool     `bson:"unique_hash"`
+	Metadata   bson.Raw `bson:"metadata"`
+	URIPolicy  bson.Raw `bson:"uri_policy"`
 }
 
 func (policy *CollectionPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -39,5 +47,5 @@ func (policy *CollectionPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 		return e.Wrap(err)
 	}
 
-	return policy.unpack(enc, ht, u.Name, u.Royalty, u.URI, u.Whites)
+	return policy.unpack(enc, ht, u.Name, u.Royalty, u.URI, u.Whites, u.Clawback, u.UniqueHash, u.Metadata, u.URIPolicy)
 }
@@ -13,11 +13,17 @@ func (policy *CollectionPolicy) unpack(
 	ry uint,
 	uri string,
 	bws []string,
+	cb bool,
+	uh bool,
+	bmd []byte,
+	bup []byte,
 ) error {
 	policy.BaseHinter = hint.NewBaseHinter(ht)
 	policy.name = CollectionName(nm)
 	policy.royalty = PaymentParameter(ry)
 	policy.uri = URI(uri)
+	policy.clawback = cb
+	policy.uniqueHash = uh
 
 	whitelist := make([]base.Address, len(bws))
 	for i, bw := range bws {
@@ -29,5 +35,17 @@ func (policy *CollectionPolicy) unpack(
 	}
 	policy.whitelist = whitelist
 
+	metadata, err := DecodeCollectionMetadata(enc, bmd)
+	if err != nil {
+		return err
+	}
+	policy.metadata = metadata
+
+	uriPolicy, err := DecodeURIPolicy(enc, bup)
+	if err != nil {
+		return err
+	}
+	policy.uriPolicy = uriPolicy
+
 	return nil
 }
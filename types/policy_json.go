@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
@@ -9,10 +11,14 @@ import (
 
 type CollectionPolicyJSONMarshaler struct {
 	hint.BaseHinter
-	Name      CollectionName   `json:"name"`
-	Royalty   PaymentParameter `json:"royalty"`
-	URI       URI              `json:"uri"`
-	Whitelist []base.Address   `json:"minter_whitelist"`
+	Name       CollectionName     `json:"name"`
+	Royalty    PaymentParameter   `json:"royalty"`
+	URI        URI                `json:"uri"`
+	Whitelist  []base.Address     `json:"minter_whitelist"`
+	Clawback   bool               `json:"clawback"`
+	UniqueHash bool               `json:"unique_hash"`
+	Metadata   CollectionMetadata `json:"metadata"`
+	URIPolicy  URIPolicy          `json:"uri_policy"`
 }
 
 func (policy CollectionPolicy) MarshalJSON() ([]byte, error) {
@@ -22,15 +28,23 @@ func (policy CollectionPolicy) MarshalJSON() ([]byte, error) {
 		Royalty:    policy.royalty,
 		URI:        policy.uri,
 		Whitelist:  policy.whitelist,
+		Clawback:   policy.clawback,
+		UniqueHash: policy.uniqueHash,
+		Metadata:   policy.metadata,
+		URIPolicy:  policy.uriPolicy,
 	})
 }
 
 type CollectionPolicyJSONUnmarshaler struct {
-	Hint      hint.Hint `json:"_hint"`
-	Name      string    `json:"name"`
-	Royalty   uint      `json:"royalty"`
-	URI       string    `json:"uri"`
-	Whitelist []string  `json:"minter_whitelist"`
+	Hint       hint.Hint       `json:"_hint"`
+	Name       string          `json:"name"`
+	Royalty    uint            `json:"royalty"`
+	URI        string          `json:"uri"`
+	Whitelist  []string        `json:"minter_whitelist"`
+	Clawback   bool            `json:"clawback"`
+	UniqueHash bool            `json:"unique_hash"`
+	Metadata   json.RawMessage `json:"metadata"`
+	URIPolicy  json.RawMessage `json:"uri_policy"`
 }
 
 func (policy *CollectionPolicy) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -41,5 +55,5 @@ func (policy *CollectionPolicy) DecodeJSON(b []byte, enc encoder.Encoder) error
 		return e.Wrap(err)
 	}
 
-	return policy.unpack(enc, u.Hint, u.Name, u.Royalty, u.URI, u.Whitelist)
+	return policy.unpack(enc, u.Hint, u.Name, u.Royalty, u.URI, u.Whitelist, u.Clawback, u.UniqueHash, u.Metadata, u.URIPolicy)
 }
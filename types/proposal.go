@@ -0,0 +1,187 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var (
+	ProposalPending  = ProposalStatus("pending")
+	ProposalApplied  = ProposalStatus("applied")
+	ProposalRejected = ProposalStatus("rejected")
+)
+
+type ProposalStatus string
+
+func (s ProposalStatus) IsValid([]byte) error {
+	if !(s == ProposalPending || s == ProposalApplied || s == ProposalRejected) {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong proposal status, %v", s))
+	}
+
+	return nil
+}
+
+func (s ProposalStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s ProposalStatus) String() string {
+	return string(s)
+}
+
+var (
+	ProposalHint = hint.MustNewHint("mitum-nft-proposal-v0.0.2")
+	// LegacyProposalHint is the proposal before action; it is decoded into Proposal with nil action.
+	LegacyProposalHint = hint.MustNewHint("mitum-nft-proposal-v0.0.1")
+)
+
+// Proposal is a collection policy change or an action waiting for the approvals of council members.
+// A pending proposal is regarded as rejected from expireHeight.
+type Proposal struct {
+	hint.BaseHinter
+	id           string
+	proposer     base.Address
+	policy       CollectionPolicy
+	action       *ProposalAction
+	approvals    []base.Address
+	expireHeight base.Height
+	status       ProposalStatus
+}
+
+func NewProposal(
+	id string,
+	proposer base.Address,
+	policy CollectionPolicy,
+	action *ProposalAction,
+	approvals []base.Address,
+	expireHeight base.Height,
+	status ProposalStatus,
+) Proposal {
+	return Proposal{
+		BaseHinter:   hint.NewBaseHinter(ProposalHint),
+		id:           id,
+		proposer:     proposer,
+		policy:       policy,
+		action:       action,
+		approvals:    approvals,
+		expireHeight: expireHeight,
+		status:       status,
+	}
+}
+
+func (p Proposal) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		p.BaseHinter,
+		p.proposer,
+		p.expireHeight,
+		p.status,
+	); err != nil {
+		return err
+	}
+
+	if p.action != nil {
+		if err := p.action.IsValid(nil); err != nil {
+			return err
+		}
+	} else if err := p.policy.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(p.id) < 1 {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("empty proposal id"))
+	}
+
+	founds := map[string]struct{}{}
+	for _, a := range p.approvals {
+		if err := a.IsValid(nil); err != nil {
+			return err
+		}
+		if _, found := founds[a.String()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("duplicate approval found, %v", a))
+		}
+		founds[a.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (p Proposal) Bytes() []byte {
+	as := make([][]byte, len(p.approvals))
+	for i, a := range p.approvals {
+		as[i] = a.Bytes()
+	}
+
+	var ab []byte
+	if p.action != nil {
+		ab = p.action.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		[]byte(p.id),
+		p.proposer.Bytes(),
+		p.policy.Bytes(),
+		util.ConcatBytesSlice(as...),
+		p.expireHeight.Bytes(),
+		p.status.Bytes(),
+		ab,
+	)
+}
+
+func (p Proposal) ID() string {
+	return p.id
+}
+
+func (p Proposal) Proposer() base.Address {
+	return p.proposer
+}
+
+func (p Proposal) Policy() CollectionPolicy {
+	return p.policy
+}
+
+// Action returns the action of proposal; it is nil for the proposal of collection policy.
+func (p Proposal) Action() *ProposalAction {
+	return p.action
+}
+
+func (p Proposal) Approvals() []base.Address {
+	return p.approvals
+}
+
+func (p Proposal) ExpireHeight() base.Height {
+	return p.expireHeight
+}
+
+func (p Proposal) Status() ProposalStatus {
+	return p.status
+}
+
+// StatusAt returns the status of the proposal at height, counting an expired pending proposal as rejected.
+func (p Proposal) StatusAt(height base.Height) ProposalStatus {
+	if p.status == ProposalPending && height >= p.expireHeight {
+		return ProposalRejected
+	}
+
+	return p.status
+}
+
+func (p Proposal) IsApprovedBy(a base.Address) bool {
+	for _, ap := range p.approvals {
+		if ap.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Proposal) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(p.approvals)+1)
+	as[0] = p.proposer
+	copy(as[1:], p.approvals)
+
+	return as, nil
+}
@@ -0,0 +1,201 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var (
+	ProposalActionGrantRole         = ProposalActionKind("grant-role")
+	ProposalActionRevokeRole        = ProposalActionKind("revoke-role")
+	ProposalActionAddSponsors       = ProposalActionKind("add-sponsors")
+	ProposalActionRemoveSponsors    = ProposalActionKind("remove-sponsors")
+	ProposalActionAddRestriction    = ProposalActionKind("add-restriction")
+	ProposalActionRemoveRestriction = ProposalActionKind("remove-restriction")
+	ProposalActionRestrictionMode   = ProposalActionKind("update-restriction-mode")
+	ProposalActionRelayers          = ProposalActionKind("update-relayers")
+)
+
+type ProposalActionKind string
+
+func (k ProposalActionKind) IsValid([]byte) error {
+	switch k {
+	case ProposalActionGrantRole,
+		ProposalActionRevokeRole,
+		ProposalActionAddSponsors,
+		ProposalActionRemoveSponsors,
+		ProposalActionAddRestriction,
+		ProposalActionRemoveRestriction,
+		ProposalActionRestrictionMode,
+		ProposalActionRelayers:
+		return nil
+	default:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong proposal action, %v", k))
+	}
+}
+
+func (k ProposalActionKind) Bytes() []byte {
+	return []byte(k)
+}
+
+func (k ProposalActionKind) String() string {
+	return string(k)
+}
+
+// IsRoleAction reports whether the action grants or revokes role.
+func (k ProposalActionKind) IsRoleAction() bool {
+	return k == ProposalActionGrantRole || k == ProposalActionRevokeRole
+}
+
+// IsAccountAction reports whether the action is applied to the accounts of
+// action; the restriction mode and the relayers are of collection.
+func (k ProposalActionKind) IsAccountAction() bool {
+	return k != ProposalActionRestrictionMode && k != ProposalActionRelayers
+}
+
+var MaxProposalActionAccounts = 100
+
+var ProposalActionHint = hint.MustNewHint("mitum-nft-proposal-action-v0.0.1")
+
+// ProposalAction is a change of collection administration, roles, sponsors,
+// restriction or relayers, which is applied once council approves the proposal.
+type ProposalAction struct {
+	hint.BaseHinter
+	kind     ProposalActionKind
+	role     Role
+	accounts []base.Address
+	mode     RestrictionMode
+	relayers *RelayerSet
+}
+
+func NewProposalAction(kind ProposalActionKind, role Role, accounts []base.Address) ProposalAction {
+	return ProposalAction{
+		BaseHinter: hint.NewBaseHinter(ProposalActionHint),
+		kind:       kind,
+		role:       role,
+		accounts:   accounts,
+	}
+}
+
+func NewRestrictionModeProposalAction(mode RestrictionMode) ProposalAction {
+	return ProposalAction{
+		BaseHinter: hint.NewBaseHinter(ProposalActionHint),
+		kind:       ProposalActionRestrictionMode,
+		mode:       mode,
+	}
+}
+
+func NewRelayersProposalAction(relayers RelayerSet) ProposalAction {
+	return ProposalAction{
+		BaseHinter: hint.NewBaseHinter(ProposalActionHint),
+		kind:       ProposalActionRelayers,
+		relayers:   &relayers,
+	}
+}
+
+func (a ProposalAction) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, a.BaseHinter, a.kind); err != nil {
+		return err
+	}
+
+	if a.kind.IsRoleAction() {
+		if err := a.role.IsValid(nil); err != nil {
+			return err
+		}
+	} else if len(a.role) > 0 {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("role given for proposal action, %v", a.kind))
+	}
+
+	switch {
+	case a.kind == ProposalActionRestrictionMode:
+		if err := a.mode.IsValid(nil); err != nil {
+			return err
+		}
+	case len(a.mode) > 0:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("restriction mode given for proposal action, %v", a.kind))
+	}
+
+	switch {
+	case a.kind == ProposalActionRelayers:
+		if a.relayers == nil {
+			return common.ErrValueInvalid.Wrap(errors.Errorf("empty relayers of proposal action"))
+		}
+
+		if err := a.relayers.IsValid(nil); err != nil {
+			return err
+		}
+	case a.relayers != nil:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("relayers given for proposal action, %v", a.kind))
+	}
+
+	if !a.kind.IsAccountAction() {
+		if len(a.accounts) > 0 {
+			return common.ErrValueInvalid.Wrap(errors.Errorf("accounts given for proposal action, %v", a.kind))
+		}
+
+		return nil
+	}
+
+	if l := len(a.accounts); l < 1 {
+		return common.ErrArrayLen.Wrap(errors.Errorf("empty accounts of proposal action"))
+	} else if l > MaxProposalActionAccounts {
+		return common.ErrArrayLen.Wrap(
+			errors.Errorf("accounts of proposal action over allowed, %d > %d", l, MaxProposalActionAccounts))
+	}
+
+	founds := map[string]struct{}{}
+	for _, acc := range a.accounts {
+		if err := acc.IsValid(nil); err != nil {
+			return err
+		}
+		if _, found := founds[acc.String()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("duplicate account found, %v", acc))
+		}
+		founds[acc.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (a ProposalAction) Bytes() []byte {
+	as := make([][]byte, len(a.accounts))
+	for i, acc := range a.accounts {
+		as[i] = acc.Bytes()
+	}
+
+	var rs []byte
+	if a.relayers != nil {
+		rs = a.relayers.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		a.kind.Bytes(),
+		a.role.Bytes(),
+		util.ConcatBytesSlice(as...),
+		a.mode.Bytes(),
+		rs,
+	)
+}
+
+func (a ProposalAction) Kind() ProposalActionKind {
+	return a.kind
+}
+
+func (a ProposalAction) Role() Role {
+	return a.role
+}
+
+func (a ProposalAction) Accounts() []base.Address {
+	return a.accounts
+}
+
+func (a ProposalAction) Mode() RestrictionMode {
+	return a.mode
+}
+
+func (a ProposalAction) Relayers() *RelayerSet {
+	return a.relayers
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (a ProposalAction) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":    a.Hint().String(),
+		"kind":     a.kind,
+		"role":     a.role,
+		"accounts": a.accounts,
+	}
+
+	if len(a.mode) > 0 {
+		m["mode"] = a.mode
+	}
+
+	if a.relayers != nil {
+		m["relayers"] = a.relayers
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type ProposalActionBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Kind     string   `bson:"kind"`
+	Role     string   `bson:"role"`
+	Accounts []string `bson:"accounts"`
+	Mode     string   `bson:"mode,omitempty"`
+	Relayers bson.Raw `bson:"relayers,omitempty"`
+}
+
+func (a *ProposalAction) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ProposalAction")
+
+	var u ProposalActionBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, ht, u.Kind, u.Role, u.Accounts, u.Mode, u.Relayers)
+}
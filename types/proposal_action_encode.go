@@ -0,0 +1,72 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (a *ProposalAction) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	kd string,
+	rl string,
+	bas []string,
+	md string,
+	bRs []byte,
+) error {
+	a.BaseHinter = hint.NewBaseHinter(ht)
+	a.kind = ProposalActionKind(kd)
+	a.role = Role(rl)
+	a.mode = RestrictionMode(md)
+
+	accounts := make([]base.Address, len(bas))
+	for i, ba := range bas {
+		acc, err := base.DecodeAddress(ba, enc)
+		if err != nil {
+			return err
+		}
+		accounts[i] = acc
+	}
+	a.accounts = accounts
+
+	if len(bRs) > 0 {
+		switch hinter, err := enc.Decode(bRs); {
+		case err != nil:
+			return err
+		case hinter == nil:
+		default:
+			rs, ok := hinter.(RelayerSet)
+			if !ok {
+				return errors.Errorf("expected RelayerSet, not %T", hinter)
+			}
+			a.relayers = &rs
+		}
+	}
+
+	return nil
+}
+
+// DecodeProposalAction decodes the hinted proposal action; the proposal of
+// collection policy has no action, so it is decoded as nil.
+func DecodeProposalAction(enc encoder.Encoder, b []byte) (*ProposalAction, error) {
+	if len(b) < 1 {
+		return nil, nil
+	}
+
+	hinter, err := enc.Decode(b)
+	switch {
+	case err != nil:
+		return nil, err
+	case hinter == nil:
+		return nil, nil
+	}
+
+	a, ok := hinter.(ProposalAction)
+	if !ok {
+		return nil, errors.Errorf("expected ProposalAction, not %T", hinter)
+	}
+
+	return &a, nil
+}
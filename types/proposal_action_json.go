@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ProposalActionJSONMarshaler struct {
+	hint.BaseHinter
+	Kind     ProposalActionKind `json:"kind"`
+	Role     Role               `json:"role,omitempty"`
+	Accounts []base.Address     `json:"accounts"`
+	Mode     RestrictionMode    `json:"mode,omitempty"`
+	Relayers *RelayerSet        `json:"relayers,omitempty"`
+}
+
+func (a ProposalAction) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposalActionJSONMarshaler{
+		BaseHinter: a.BaseHinter,
+		Kind:       a.kind,
+		Role:       a.role,
+		Accounts:   a.accounts,
+		Mode:       a.mode,
+		Relayers:   a.relayers,
+	})
+}
+
+type ProposalActionJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Kind     string          `json:"kind"`
+	Role     string          `json:"role"`
+	Accounts []string        `json:"accounts"`
+	Mode     string          `json:"mode"`
+	Relayers json.RawMessage `json:"relayers"`
+}
+
+func (a *ProposalAction) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ProposalAction")
+
+	var u ProposalActionJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, u.Hint, u.Kind, u.Role, u.Accounts, u.Mode, u.Relayers)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (p Proposal) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":         p.Hint().String(),
+		"id":            p.id,
+		"proposer":      p.proposer,
+		"approvals":     p.approvals,
+		"expire_height": p.expireHeight,
+		"status":        p.status,
+	}
+
+	if p.action != nil {
+		m["action"] = p.action
+	} else {
+		m["policy"] = p.policy
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type ProposalBSONUnmarshaler struct {
+	Hint         string   `bson:"_hint"`
+	ID           string   `bson:"id"`
+	Proposer     string   `bson:"proposer"`
+	Policy       bson.Raw `bson:"policy,omitempty"`
+	Action       bson.Raw `bson:"action,omitempty"`
+	Approvals    []string `bson:"approvals"`
+	ExpireHeight int64    `bson:"expire_height"`
+	Status       string   `bson:"status"`
+}
+
+func (p *Proposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Proposal")
+
+	var u ProposalBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return p.unpack(enc, ht, u.ID, u.Proposer, u.Policy, u.Action, u.Approvals, u.ExpireHeight, u.Status)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (p *Proposal) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	id string,
+	pr string,
+	bPcy []byte,
+	bAct []byte,
+	bas []string,
+	eh int64,
+	st string,
+) error {
+	p.BaseHinter = hint.NewBaseHinter(ht)
+	p.id = id
+	p.expireHeight = base.Height(eh)
+	p.status = ProposalStatus(st)
+
+	proposer, err := base.DecodeAddress(pr, enc)
+	if err != nil {
+		return err
+	}
+	p.proposer = proposer
+
+	action, err := DecodeProposalAction(enc, bAct)
+	if err != nil {
+		return err
+	}
+	p.action = action
+
+	if len(bPcy) > 0 {
+		switch hinter, err := enc.Decode(bPcy); {
+		case err != nil:
+			return err
+		case hinter == nil:
+		default:
+			po, ok := hinter.(CollectionPolicy)
+			if !ok {
+				return errors.Errorf("expected CollectionPolicy, not %T", hinter)
+			}
+			p.policy = po
+		}
+	}
+
+	approvals := make([]base.Address, len(bas))
+	for i, ba := range bas {
+		a, err := base.DecodeAddress(ba, enc)
+		if err != nil {
+			return err
+		}
+		approvals[i] = a
+	}
+	p.approvals = approvals
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ProposalJSONMarshaler struct {
+	hint.BaseHinter
+	ID           string            `json:"id"`
+	Proposer     base.Address      `json:"proposer"`
+	Policy       *CollectionPolicy `json:"policy,omitempty"`
+	Action       *ProposalAction   `json:"action,omitempty"`
+	Approvals    []base.Address    `json:"approvals"`
+	ExpireHeight base.Height       `json:"expire_height"`
+	Status       ProposalStatus    `json:"status"`
+}
+
+func (p Proposal) MarshalJSON() ([]byte, error) {
+	var policy *CollectionPolicy
+	if p.action == nil {
+		policy = &p.policy
+	}
+
+	return util.MarshalJSON(ProposalJSONMarshaler{
+		BaseHinter:   p.BaseHinter,
+		ID:           p.id,
+		Proposer:     p.proposer,
+		Policy:       policy,
+		Action:       p.action,
+		Approvals:    p.approvals,
+		ExpireHeight: p.expireHeight,
+		Status:       p.status,
+	})
+}
+
+type ProposalJSONUnmarshaler struct {
+	Hint         hint.Hint       `json:"_hint"`
+	ID           string          `json:"id"`
+	Proposer     string          `json:"proposer"`
+	Policy       json.RawMessage `json:"policy"`
+	Action       json.RawMessage `json:"action"`
+	Approvals    []string        `json:"approvals"`
+	ExpireHeight int64           `json:"expire_height"`
+	Status       string          `json:"status"`
+}
+
+func (p *Proposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Proposal")
+
+	var u ProposalJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return p.unpack(enc, u.Hint, u.ID, u.Proposer, u.Policy, u.Action, u.Approvals, u.ExpireHeight, u.Status)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var ProvenanceHint = hint.MustNewHint("mitum-nft-provenance-v0.0.1")
+
+// Provenance records the collection and the index of nft where the migrated
+// nft came from; nft minted in its own collection has empty provenance.
+type Provenance struct {
+	hint.BaseHinter
+	contract base.Address
+	idx      uint64
+}
+
+func NewProvenance(contract base.Address, idx uint64) Provenance {
+	return Provenance{
+		BaseHinter: hint.NewBaseHinter(ProvenanceHint),
+		contract:   contract,
+		idx:        idx,
+	}
+}
+
+func (p Provenance) IsValid([]byte) error {
+	if p.IsEmpty() {
+		return nil
+	}
+
+	return util.CheckIsValiders(nil, false,
+		p.BaseHinter,
+		p.contract,
+	)
+}
+
+func (p Provenance) IsEmpty() bool {
+	return p.contract == nil
+}
+
+func (p Provenance) Bytes() []byte {
+	if p.IsEmpty() {
+		return nil
+	}
+
+	return util.ConcatBytesSlice(
+		p.contract.Bytes(),
+		util.Uint64ToBytes(p.idx),
+	)
+}
+
+func (p Provenance) Contract() base.Address {
+	return p.contract
+}
+
+func (p Provenance) NFTIdx() uint64 {
+	return p.idx
+}
+
+func (p Provenance) Equal(cp Provenance) bool {
+	switch {
+	case p.IsEmpty() || cp.IsEmpty():
+		return p.IsEmpty() == cp.IsEmpty()
+	case !p.contract.Equal(cp.contract):
+		return false
+	default:
+		return p.idx == cp.idx
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (p *Provenance) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ca string,
+	idx uint64,
+) error {
+	p.BaseHinter = hint.NewBaseHinter(ht)
+	p.idx = idx
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return err
+	default:
+		p.contract = a
+	}
+
+	return nil
+}
+
+// DecodeProvenance decodes the hinted provenance; missing provenance of the
+// nfts minted before migration is decoded as empty provenance.
+func DecodeProvenance(enc encoder.Encoder, b []byte) (Provenance, error) {
+	if len(b) < 1 {
+		return Provenance{}, nil
+	}
+
+	hinter, err := enc.Decode(b)
+	switch {
+	case err != nil:
+		return Provenance{}, err
+	case hinter == nil:
+		return Provenance{}, nil
+	}
+
+	p, ok := hinter.(Provenance)
+	if !ok {
+		return Provenance{}, errors.Errorf("expected Provenance, not %T", hinter)
+	}
+
+	return p, nil
+}
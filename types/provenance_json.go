@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ProvenanceJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address `json:"contract"`
+	NFTIdx   uint64       `json:"nft_idx"`
+}
+
+func (p Provenance) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProvenanceJSONMarshaler{
+		BaseHinter: p.BaseHinter,
+		Contract:   p.contract,
+		NFTIdx:     p.idx,
+	})
+}
+
+type ProvenanceJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	NFTIdx   uint64    `json:"nft_idx"`
+}
+
+func (p *Provenance) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Provenance")
+
+	var u ProvenanceJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return p.unpack(enc, u.Hint, u.Contract, u.NFTIdx)
+}
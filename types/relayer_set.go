@@ -0,0 +1,119 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var MaxRelayers = 20
+
+var RelayerSetHint = hint.MustNewHint("mitum-nft-relayer-set-v0.0.1")
+
+// RelayerSet is the set of relayer keys which attest the bridged nfts on the
+// remote chain; a bridge release is accepted with threshold relayer signs.
+type RelayerSet struct {
+	hint.BaseHinter
+	keys      []mitumbase.Publickey
+	threshold uint
+}
+
+func NewRelayerSet(keys []mitumbase.Publickey, threshold uint) RelayerSet {
+	return RelayerSet{
+		BaseHinter: hint.NewBaseHinter(RelayerSetHint),
+		keys:       keys,
+		threshold:  threshold,
+	}
+}
+
+func (rs RelayerSet) IsValid([]byte) error {
+	if err := rs.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	l := len(rs.keys)
+	if l < 1 {
+		return common.ErrArrayLen.Wrap(errors.Errorf("empty relayer keys"))
+	} else if l > MaxRelayers {
+		return common.ErrArrayLen.Wrap(errors.Errorf("relayer keys over allowed, %d > %d", l, MaxRelayers))
+	}
+
+	if rs.threshold < 1 || rs.threshold > uint(l) {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("relayer threshold out of range, 1 <= %d <= %d", rs.threshold, l))
+	}
+
+	founds := map[string]struct{}{}
+	for _, k := range rs.keys {
+		if err := k.IsValid(nil); err != nil {
+			return err
+		}
+		if _, found := founds[k.String()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("duplicate relayer key found, %v", k))
+		}
+		founds[k.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (rs RelayerSet) Bytes() []byte {
+	bs := make([][]byte, len(rs.keys))
+	for i, k := range rs.keys {
+		bs[i] = k.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(bs...),
+		util.UintToBytes(rs.threshold),
+	)
+}
+
+func (rs RelayerSet) Keys() []mitumbase.Publickey {
+	return rs.keys
+}
+
+func (rs RelayerSet) Threshold() uint {
+	return rs.threshold
+}
+
+func (rs RelayerSet) IsRelayer(k mitumbase.Publickey) bool {
+	for _, r := range rs.keys {
+		if r.Equal(k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Verify checks that b is signed by threshold relayers; the signs of unknown
+// keys and the duplicated signs of the same relayer are not counted.
+func (rs RelayerSet) Verify(b []byte, signs []RelayerSign) error {
+	var count uint
+	founds := map[string]struct{}{}
+	for _, s := range signs {
+		if !rs.IsRelayer(s.Signer()) {
+			continue
+		}
+
+		if _, found := founds[s.Signer().String()]; found {
+			continue
+		}
+
+		if err := s.Verify(b); err != nil {
+			return errors.Wrapf(err, "relayer %v", s.Signer())
+		}
+
+		founds[s.Signer().String()] = struct{}{}
+		count++
+	}
+
+	if count < rs.threshold {
+		return errors.Errorf("not enough relayer signs, %d < %d", count, rs.threshold)
+	}
+
+	return nil
+}
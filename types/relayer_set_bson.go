@@ -0,0 +1,44 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (rs RelayerSet) MarshalBSON() ([]byte, error) {
+	keys := make([]string, len(rs.keys))
+	for i, k := range rs.keys {
+		keys[i] = k.String()
+	}
+
+	return bsonenc.Marshal(bson.M{
+		"_hint":     rs.Hint().String(),
+		"keys":      keys,
+		"threshold": rs.threshold,
+	})
+}
+
+type RelayerSetBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Keys      []string `bson:"keys"`
+	Threshold uint     `bson:"threshold"`
+}
+
+func (rs *RelayerSet) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RelayerSet")
+
+	var u RelayerSetBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return rs.unpack(enc, ht, u.Keys, u.Threshold)
+}
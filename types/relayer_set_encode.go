@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (rs *RelayerSet) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bks []string,
+	th uint,
+) error {
+	rs.BaseHinter = hint.NewBaseHinter(ht)
+	rs.threshold = th
+
+	keys := make([]base.Publickey, len(bks))
+	for i, bk := range bks {
+		k, err := base.DecodePublickeyFromString(bk, enc)
+		if err != nil {
+			return err
+		}
+		keys[i] = k
+	}
+	rs.keys = keys
+
+	return nil
+}
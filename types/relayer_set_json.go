@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RelayerSetJSONMarshaler struct {
+	hint.BaseHinter
+	Keys      []base.Publickey `json:"keys"`
+	Threshold uint             `json:"threshold"`
+}
+
+func (rs RelayerSet) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RelayerSetJSONMarshaler{
+		BaseHinter: rs.BaseHinter,
+		Keys:       rs.keys,
+		Threshold:  rs.threshold,
+	})
+}
+
+type RelayerSetJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Keys      []string  `json:"keys"`
+	Threshold uint      `json:"threshold"`
+}
+
+func (rs *RelayerSet) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RelayerSet")
+
+	var u RelayerSetJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return rs.unpack(enc, u.Hint, u.Keys, u.Threshold)
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func newTestRelayerKeys(t *testing.T, n int) []base.Privatekey {
+	t.Helper()
+
+	privs := make([]base.Privatekey, n)
+	for i := range privs {
+		privs[i] = base.NewMPrivatekey()
+	}
+
+	return privs
+}
+
+func newTestRelayerSet(privs []base.Privatekey, threshold uint) RelayerSet {
+	keys := make([]base.Publickey, len(privs))
+	for i := range privs {
+		keys[i] = privs[i].Publickey()
+	}
+
+	return NewRelayerSet(keys, threshold)
+}
+
+func signTestRelayers(t *testing.T, privs []base.Privatekey, b []byte) []RelayerSign {
+	t.Helper()
+
+	signs := make([]RelayerSign, len(privs))
+	for i := range privs {
+		s, err := NewRelayerSignFromBytes(privs[i], b)
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+
+		signs[i] = s
+	}
+
+	return signs
+}
+
+func testReleaseMessage(networkID base.NetworkID, nonce uint64) []byte {
+	return BridgeReleaseMessage(
+		networkID, base.NewStringAddress("contract"), 3, nonce, base.NewStringAddress("receiver"))
+}
+
+func TestRelayerSetIsValid(t *testing.T) {
+	privs := newTestRelayerKeys(t, 3)
+
+	if err := newTestRelayerSet(privs, 2).IsValid(nil); err != nil {
+		t.Errorf("unexpected error, %v", err)
+	}
+
+	for _, threshold := range []uint{0, 4} {
+		if err := newTestRelayerSet(privs, threshold).IsValid(nil); err == nil {
+			t.Errorf("threshold %d: expected error", threshold)
+		}
+	}
+
+	if err := newTestRelayerSet(append(privs, privs[0]), 2).IsValid(nil); err == nil {
+		t.Error("duplicated key: expected error")
+	}
+}
+
+func TestRelayerSetVerify(t *testing.T) {
+	privs := newTestRelayerKeys(t, 3)
+	rs := newTestRelayerSet(privs, 2)
+	b := testReleaseMessage(base.NetworkID("test"), 1)
+
+	if err := rs.Verify(b, signTestRelayers(t, privs[:2], b)); err != nil {
+		t.Errorf("threshold signs: unexpected error, %v", err)
+	}
+
+	if err := rs.Verify(b, signTestRelayers(t, privs, b)); err != nil {
+		t.Errorf("all signs: unexpected error, %v", err)
+	}
+
+	if err := rs.Verify(b, signTestRelayers(t, privs[:1], b)); err == nil {
+		t.Error("under threshold: expected error")
+	}
+
+	if err := rs.Verify(b, nil); err == nil {
+		t.Error("no signs: expected error")
+	}
+}
+
+func TestRelayerSetVerifyNotCounted(t *testing.T) {
+	privs := newTestRelayerKeys(t, 3)
+	rs := newTestRelayerSet(privs, 2)
+	b := testReleaseMessage(base.NetworkID("test"), 1)
+
+	signs := signTestRelayers(t, privs[:1], b)
+
+	// NOTE the same relayer signs twice.
+	if err := rs.Verify(b, append(signs, signs[0])); err == nil {
+		t.Error("duplicated signs: expected error")
+	}
+
+	// NOTE the key which is not in relayer set signs.
+	others := signTestRelayers(t, newTestRelayerKeys(t, 1), b)
+	if err := rs.Verify(b, append(signs, others...)); err == nil {
+		t.Error("unknown signer: expected error")
+	}
+}
+
+func TestRelayerSetVerifyWrongSignature(t *testing.T) {
+	privs := newTestRelayerKeys(t, 3)
+	rs := newTestRelayerSet(privs, 2)
+	b := testReleaseMessage(base.NetworkID("test"), 1)
+
+	signs := signTestRelayers(t, privs[:2], b)
+
+	// NOTE relayer key with the signature of the other message.
+	wrong := signTestRelayers(t, privs[1:2], testReleaseMessage(base.NetworkID("test"), 2))
+	signs[1] = NewRelayerSign(privs[1].Publickey(), wrong[0].Signature())
+
+	if err := rs.Verify(b, signs); err == nil {
+		t.Error("wrong signature: expected error")
+	}
+}
+
+func TestRelayerSetVerifyReplay(t *testing.T) {
+	privs := newTestRelayerKeys(t, 3)
+	rs := newTestRelayerSet(privs, 2)
+
+	lock := NewBridgeLock(base.NewStringAddress("owner"), "chain", "recipient", 1, true)
+	contract := base.NewStringAddress("contract")
+	receiver := base.NewStringAddress("receiver")
+	networkID := base.NetworkID("test")
+
+	signs := signTestRelayers(t, privs[:2], lock.ReleaseMessage(networkID, contract, 3, receiver))
+
+	if err := rs.Verify(lock.ReleaseMessage(networkID, contract, 3, receiver), signs); err != nil {
+		t.Fatalf("unexpected error, %v", err)
+	}
+
+	// NOTE the signs for the previous lock can not release nft locked again.
+	relocked := NewBridgeLock(lock.Owner(), lock.Chain(), lock.Recipient(), lock.Nonce()+1, true)
+	if err := rs.Verify(relocked.ReleaseMessage(networkID, contract, 3, receiver), signs); err == nil {
+		t.Error("next nonce: expected error")
+	}
+
+	// NOTE the signs in one network can not be replayed in another network.
+	if err := rs.Verify(lock.ReleaseMessage(base.NetworkID("other"), contract, 3, receiver), signs); err == nil {
+		t.Error("other network: expected error")
+	}
+
+	if err := rs.Verify(lock.ReleaseMessage(networkID, contract, 3, base.NewStringAddress("other")), signs); err == nil {
+		t.Error("other receiver: expected error")
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var RelayerSignHint = hint.MustNewHint("mitum-nft-relayer-sign-v0.0.1")
+
+// RelayerSign is the signature of relayer over the bridge message, which the
+// relayer makes after it observes the bridged nft returned on the remote chain.
+type RelayerSign struct {
+	hint.BaseHinter
+	signer    base.Publickey
+	signature base.Signature
+}
+
+func NewRelayerSign(signer base.Publickey, signature base.Signature) RelayerSign {
+	return RelayerSign{
+		BaseHinter: hint.NewBaseHinter(RelayerSignHint),
+		signer:     signer,
+		signature:  signature,
+	}
+}
+
+// NewRelayerSignFromBytes signs b with the relayer key, priv.
+func NewRelayerSignFromBytes(priv base.Privatekey, b []byte) (RelayerSign, error) {
+	sig, err := priv.Sign(b)
+	if err != nil {
+		return RelayerSign{}, errors.Wrap(err, "sign bridge message")
+	}
+
+	return NewRelayerSign(priv.Publickey(), sig), nil
+}
+
+func (rs RelayerSign) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		rs.BaseHinter,
+		rs.signer,
+		rs.signature,
+	)
+}
+
+func (rs RelayerSign) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		rs.signer.Bytes(),
+		rs.signature.Bytes(),
+	)
+}
+
+func (rs RelayerSign) Signer() base.Publickey {
+	return rs.signer
+}
+
+func (rs RelayerSign) Signature() base.Signature {
+	return rs.signature
+}
+
+func (rs RelayerSign) Verify(b []byte) error {
+	return rs.signer.Verify(b, rs.signature)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (rs RelayerSign) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":     rs.Hint().String(),
+		"signer":    rs.signer.String(),
+		"signature": rs.signature.String(),
+	})
+}
+
+type RelayerSignBSONUnmarshaler struct {
+	Hint      string `bson:"_hint"`
+	Signer    string `bson:"signer"`
+	Signature string `bson:"signature"`
+}
+
+func (rs *RelayerSign) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RelayerSign")
+
+	var u RelayerSignBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	var sig base.Signature
+	if err := sig.UnmarshalText([]byte(u.Signature)); err != nil {
+		return e.Wrap(err)
+	}
+
+	return rs.unpack(enc, ht, u.Signer, sig)
+}
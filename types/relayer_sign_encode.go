@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (rs *RelayerSign) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	sg string,
+	sig base.Signature,
+) error {
+	rs.BaseHinter = hint.NewBaseHinter(ht)
+	rs.signature = sig
+
+	signer, err := base.DecodePublickeyFromString(sg, enc)
+	if err != nil {
+		return err
+	}
+	rs.signer = signer
+
+	return nil
+}
+
+func DecodeRelayerSigns(enc encoder.Encoder, b []byte) ([]RelayerSign, error) {
+	if len(b) < 1 {
+		return nil, nil
+	}
+
+	hinters, err := enc.DecodeSlice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	signs := make([]RelayerSign, len(hinters))
+	for i, hinter := range hinters {
+		s, ok := hinter.(RelayerSign)
+		if !ok {
+			return nil, errors.Errorf("expected RelayerSign, not %T", hinter)
+		}
+
+		signs[i] = s
+	}
+
+	return signs, nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RelayerSignJSONMarshaler struct {
+	hint.BaseHinter
+	Signer    base.Publickey `json:"signer"`
+	Signature base.Signature `json:"signature"`
+}
+
+func (rs RelayerSign) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RelayerSignJSONMarshaler{
+		BaseHinter: rs.BaseHinter,
+		Signer:     rs.signer,
+		Signature:  rs.signature,
+	})
+}
+
+type RelayerSignJSONUnmarshaler struct {
+	Hint      hint.Hint      `json:"_hint"`
+	Signer    string         `json:"signer"`
+	Signature base.Signature `json:"signature"`
+}
+
+func (rs *RelayerSign) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RelayerSign")
+
+	var u RelayerSignJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return rs.unpack(enc, u.Hint, u.Signer, u.Signature)
+}
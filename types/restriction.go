@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	RestrictionNone  = RestrictionMode("none")
+	RestrictionDeny  = RestrictionMode("deny")
+	RestrictionAllow = RestrictionMode("allow")
+)
+
+// RestrictionMode decides how the restricted accounts of a collection are applied to nft receivers.
+type RestrictionMode string
+
+func (mode RestrictionMode) IsValid([]byte) error {
+	if !(mode == RestrictionNone || mode == RestrictionDeny || mode == RestrictionAllow) {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong restriction mode, %v", mode))
+	}
+
+	return nil
+}
+
+func (mode RestrictionMode) Bytes() []byte {
+	return []byte(mode)
+}
+
+func (mode RestrictionMode) String() string {
+	return string(mode)
+}
+
+// Permits reports whether a receiver can hold nfts; listed is true when the receiver is in the restriction list.
+func (mode RestrictionMode) Permits(listed bool) bool {
+	switch mode {
+	case RestrictionDeny:
+		return !listed
+	case RestrictionAllow:
+		return listed
+	default:
+		return true
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	RoleAdmin          = Role("admin")
+	RoleMinter         = Role("minter")
+	RoleMetadataEditor = Role("metadata-editor")
+)
+
+// Role is a duty of collection administration which can be granted to an account.
+type Role string
+
+func (r Role) IsValid([]byte) error {
+	switch r {
+	case RoleAdmin, RoleMinter, RoleMetadataEditor:
+		return nil
+	default:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong role, %v", r))
+	}
+}
+
+func (r Role) Bytes() []byte {
+	return []byte(r)
+}
+
+func (r Role) String() string {
+	return string(r)
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxURISchemes    = 10
+	ReValidURIScheme = regexp.MustCompile(`^[a-z][a-z0-9+.\-]*$`)
+)
+
+var URIPolicyHint = hint.MustNewHint("mitum-nft-uri-policy-v0.0.1")
+
+// URIPolicy restricts the schemes of nft uris in collection and, with base
+// uri, derives the uri of nft, "{base}/{idx}.json", when it is omitted at minting.
+type URIPolicy struct {
+	hint.BaseHinter
+	schemes []string
+	baseURI URI
+}
+
+func NewURIPolicy(schemes []string, baseURI URI) URIPolicy {
+	return URIPolicy{
+		BaseHinter: hint.NewBaseHinter(URIPolicyHint),
+		schemes:    schemes,
+		baseURI:    baseURI,
+	}
+}
+
+func (up URIPolicy) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		up.BaseHinter,
+		up.baseURI,
+	); err != nil {
+		return err
+	}
+
+	if l := len(up.schemes); l > MaxURISchemes {
+		return common.ErrArrayLen.Wrap(errors.Errorf("uri schemes over allowed, %d > %d", l, MaxURISchemes))
+	}
+
+	founds := map[string]struct{}{}
+	for _, s := range up.schemes {
+		if !ReValidURIScheme.MatchString(s) {
+			return util.ErrInvalid.Errorf("wrong uri scheme, %q", s)
+		}
+
+		if _, found := founds[s]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("uri scheme, %v", s))
+		}
+		founds[s] = struct{}{}
+	}
+
+	if up.baseURI != "" {
+		if err := up.Permits(up.baseURI); err != nil {
+			return errors.WithMessage(err, "base uri")
+		}
+	}
+
+	return nil
+}
+
+func (up URIPolicy) Bytes() []byte {
+	bs := make([][]byte, len(up.schemes))
+	for i, s := range up.schemes {
+		bs[i] = []byte(s)
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(bs...),
+		up.baseURI.Bytes(),
+	)
+}
+
+func (up URIPolicy) Schemes() []string {
+	return up.schemes
+}
+
+func (up URIPolicy) BaseURI() URI {
+	return up.baseURI
+}
+
+// Permits checks the scheme of uri; every scheme is allowed when no scheme is given.
+func (up URIPolicy) Permits(uri URI) error {
+	if len(up.schemes) < 1 {
+		return nil
+	}
+
+	u, err := url.Parse(uri.String())
+	if err != nil {
+		return util.ErrInvalid.Wrap(err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	for _, s := range up.schemes {
+		if s == scheme {
+			return nil
+		}
+	}
+
+	return util.ErrInvalid.Errorf("uri scheme %q not allowed, %v", u.Scheme, up.schemes)
+}
+
+// PermitsAll checks the schemes of the non-empty uris.
+func (up URIPolicy) PermitsAll(uris ...URI) error {
+	for _, uri := range uris {
+		if uri == "" {
+			continue
+		}
+
+		if err := up.Permits(uri); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Resolve returns the uri of nft of idx; the given uri should be permitted
+// and the empty uri is derived from base uri.
+func (up URIPolicy) Resolve(uri URI, idx uint64) (URI, error) {
+	if uri != "" {
+		if err := up.Permits(uri); err != nil {
+			return "", err
+		}
+
+		return uri, nil
+	}
+
+	if up.baseURI == "" {
+		return "", util.ErrInvalid.Errorf("empty uri without base uri")
+	}
+
+	return URI(
+		strings.TrimRight(up.baseURI.String(), "/") + "/" + strconv.FormatUint(idx, 10) + ".json",
+	), nil
+}
+
+func (up URIPolicy) Equal(cup URIPolicy) bool {
+	if up.baseURI != cup.baseURI {
+		return false
+	}
+
+	if len(up.schemes) != len(cup.schemes) {
+		return false
+	}
+
+	for i := range up.schemes {
+		if up.schemes[i] != cup.schemes[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (up URIPolicy) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":    up.Hint().String(),
+		"schemes":  up.schemes,
+		"base_uri": up.baseURI,
+	})
+}
+
+type URIPolicyBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Schemes []string `bson:"schemes"`
+	BaseURI string   `bson:"base_uri"`
+}
+
+func (up *URIPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of URIPolicy")
+
+	var u URIPolicyBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return up.unpack(enc, ht, u.Schemes, u.BaseURI)
+}
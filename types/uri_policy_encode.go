@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (up *URIPolicy) unpack(
+	_ encoder.Encoder,
+	ht hint.Hint,
+	schemes []string,
+	bu string,
+) error {
+	up.BaseHinter = hint.NewBaseHinter(ht)
+	up.schemes = schemes
+	up.baseURI = URI(bu)
+
+	return nil
+}
+
+// DecodeURIPolicy decodes the hinted uri policy; missing uri policy of the
+// older policies is decoded as the policy allowing every scheme.
+func DecodeURIPolicy(enc encoder.Encoder, b []byte) (URIPolicy, error) {
+	if len(b) < 1 {
+		return NewURIPolicy(nil, ""), nil
+	}
+
+	hinter, err := enc.Decode(b)
+	switch {
+	case err != nil:
+		return URIPolicy{}, err
+	case hinter == nil:
+		return NewURIPolicy(nil, ""), nil
+	}
+
+	up, ok := hinter.(URIPolicy)
+	if !ok {
+		return URIPolicy{}, errors.Errorf("expected URIPolicy, not %T", hinter)
+	}
+
+	return up, nil
+}
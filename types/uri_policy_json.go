@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type URIPolicyJSONMarshaler struct {
+	hint.BaseHinter
+	Schemes []string `json:"schemes"`
+	BaseURI URI      `json:"base_uri"`
+}
+
+func (up URIPolicy) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(URIPolicyJSONMarshaler{
+		BaseHinter: up.BaseHinter,
+		Schemes:    up.schemes,
+		BaseURI:    up.baseURI,
+	})
+}
+
+type URIPolicyJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Schemes []string  `json:"schemes"`
+	BaseURI string    `json:"base_uri"`
+}
+
+func (up *URIPolicy) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of URIPolicy")
+
+	var u URIPolicyJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return up.unpack(enc, u.Hint, u.Schemes, u.BaseURI)
+}